@@ -0,0 +1,44 @@
+package parser
+
+import "strings"
+
+type QueryArrayStyle int
+
+const (
+	// QueryArrayExplode expects repeated keys like ids=1&ids=2.
+	QueryArrayExplode QueryArrayStyle = iota
+	// QueryArrayForm splits a single value like ids=1,2.
+	QueryArrayForm
+	// QueryArraySpaceDelimited splits a single value like ids=1%202.
+	QueryArraySpaceDelimited
+	// QueryArrayPipeDelimited splits a single value like ids=1|2.
+	QueryArrayPipeDelimited
+)
+
+func (s QueryArrayStyle) separator() string {
+	switch s {
+	case QueryArrayForm:
+		return ","
+	case QueryArraySpaceDelimited:
+		return " "
+	case QueryArrayPipeDelimited:
+		return "|"
+	}
+	return ""
+}
+
+func (p *Parser) splitQueryArray(values []string, target any) []string {
+	sep := p.queryArrayStyle.separator()
+	if len(sep) == 0 || !isSliceTarget(target) {
+		return values
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, item := range strings.Split(value, sep) {
+			if len(item) > 0 {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxPooledBufferSize keeps a single huge body from staying pinned in the pool.
+const maxPooledBufferSize = 4 << 20
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// Reset returns the replay buffer to the pool. Bytes returned by earlier reads must not be used afterwards.
+func (p *Parser) Reset() {
+	if p.buffer == nil {
+		return
+	}
+	releaseBuffer(p.buffer)
+	p.buffer = nil
+	p.r.Body = http.NoBody
+}
+
+func (p *Parser) readReplayBytes() ([]byte, error) {
+	if p.buffer == nil {
+		buffer := bufferPool.Get().(*bytes.Buffer)
+		if _, err := buffer.ReadFrom(p.body()); err != nil {
+			releaseBuffer(buffer)
+			return nil, err
+		}
+		p.buffer = buffer
+	}
+	data := p.buffer.Bytes()
+	p.r.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// bodyReader returns the body for streaming readers, served from the replay buffer when replay is enabled.
+func (p *Parser) bodyReader() (io.Reader, error) {
+	if !p.replayBody {
+		return p.body(), nil
+	}
+	data, err := p.readReplayBytes()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+func releaseBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+	buffer.Reset()
+	bufferPool.Put(buffer)
+}
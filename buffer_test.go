@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func createLargeJson() []byte {
+	var b strings.Builder
+	b.WriteString(`{"items":[`)
+	for i := 0; i < 20000; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(`{"id":1,"name":"item"}`)
+	}
+	b.WriteString(`]}`)
+	return []byte(b.String())
+}
+
+func TestCloneSurvivesReset(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", strings.NewReader("payload"))
+	p := New(r, nil, 1, WithRequestBodyReplay())
+	if _, err := p.Text(); err != nil {
+		t.Fatal(err)
+	}
+	c := p.Clone()
+	p.Reset()
+	pooled := bufferPool.Get().(*bytes.Buffer)
+	pooled.WriteString("overwritten")
+	defer releaseBuffer(pooled)
+	text, err := c.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "payload" {
+		t.Fatalf("expected clone to keep payload, got %q", text)
+	}
+}
+
+func TestReleaseBufferDropsLargeBuffers(t *testing.T) {
+	buffer := bytes.NewBuffer(make([]byte, 0, maxPooledBufferSize+1))
+	releaseBuffer(buffer)
+	if pooled := bufferPool.Get().(*bytes.Buffer); pooled == buffer {
+		t.Fatal("expected an oversized buffer to be dropped")
+	}
+}
+
+func BenchmarkJsonReplay(b *testing.B) {
+	body := createLargeJson()
+	b.ReportAllocs()
+	b.SetBytes(int64(len(body)))
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			r := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+			p := New(r, nil, 10, WithRequestBodyReplay())
+			var target struct {
+				Items []struct {
+					Id   int    `json:"id"`
+					Name string `json:"name"`
+				} `json:"items"`
+			}
+			if err := p.Json(&target); err != nil {
+				b.Fatal(err)
+			}
+			p.Reset()
+		}
+	})
+}
+
+func TestReplayAfterStreamingReaders(t *testing.T) {
+	readers := map[string]func(p *Parser) error{
+		"csv": func(p *Parser) error {
+			return p.CsvRows(func([]string, int) error { return nil })
+		},
+		"events": func(p *Parser) error {
+			return p.EventStream(func(string, []byte) error { return nil })
+		},
+		"text reader": func(p *Parser) error {
+			reader, err := p.TextReader()
+			if err != nil {
+				return err
+			}
+			_, err = io.ReadAll(reader)
+			return err
+		},
+	}
+	for name, read := range readers {
+		body := "data: a,b\n\n"
+		p := New(httptest.NewRequest("POST", "/", strings.NewReader(body)), nil, 1, WithRequestBodyReplay())
+		if err := read(p); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if text, err := p.Text(); err != nil || text != body {
+			t.Errorf("%s: expected replayed body, got %q, %v", name, text, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package parser
+
+import (
+	"bytes"
+	"maps"
+	"slices"
+)
+
+// Clone derives a Parser for the same request with additional options applied on top of the current ones.
+// Replayed body bytes are copied, so the body is not read again and Reset on either Parser leaves the other intact. A limit already applied
+// to the request body cannot be raised by the clone.
+func (p *Parser) Clone(options ...Option) *Parser {
+	c := *p
+	c.depth = 0
+	c.buffer = nil
+	if len(c.bytes) == 0 && p.buffer != nil {
+		c.bytes = bytes.Clone(p.buffer.Bytes())
+	}
+	c.typeResolvers = maps.Clone(p.typeResolvers)
+	c.trustedProxies = slices.Clone(p.trustedProxies)
+	c.duplicateFiles = nil
+	c.queryIndex = nil
+	c.queryValues = nil
+	c.fragmentValues = nil
+	for _, option := range options {
+		option(&c)
+	}
+	return &c
+}
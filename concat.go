@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+)
+
+type lazyFileReader struct {
+	header *multipart.FileHeader
+	file   multipart.File
+	done   bool
+}
+
+type concatReader struct {
+	io.Reader
+	files []*lazyFileReader
+}
+
+// ConcatFiles reads the files of one field back to back. Close the reader when not reading it to the end,
+// so the file being read is released.
+func (p *Parser) ConcatFiles(formKey string) (reader io.ReadCloser, err error) {
+	defer p.finish("ConcatFiles", p.start(), &err)
+	if len(p.bytes) > 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	if err := p.parseMultipartForm(); err != nil {
+		return nil, err
+	}
+	if p.nestedMultiparts != nil {
+		readers := make([]io.Reader, 0)
+		for _, m := range p.nestedMultiparts {
+			if m.Key == formKey {
+				readers = append(readers, bytes.NewReader(m.Data))
+			}
+		}
+		return io.NopCloser(io.MultiReader(readers...)), nil
+	}
+	files := p.r.MultipartForm.File[formKey]
+	for _, file := range files {
+		if err := p.validateFilename(file.Filename); err != nil {
+			return nil, err
+		}
+	}
+	lazyFiles := make([]*lazyFileReader, len(files))
+	readers := make([]io.Reader, len(files))
+	for i, file := range files {
+		lazyFiles[i] = &lazyFileReader{header: file}
+		readers[i] = lazyFiles[i]
+	}
+	return &concatReader{Reader: io.MultiReader(readers...), files: lazyFiles}, nil
+}
+
+func (p *Parser) MustConcatFiles(formKey string) io.ReadCloser {
+	reader, err := p.ConcatFiles(formKey)
+	if err != nil {
+		p.fail(err)
+	}
+	return reader
+}
+
+func (r *concatReader) Close() error {
+	for _, file := range r.files {
+		file.close()
+	}
+	return nil
+}
+
+func (r *lazyFileReader) Read(b []byte) (int, error) {
+	if r.done {
+		return 0, io.EOF
+	}
+	if r.file == nil {
+		file, err := r.header.Open()
+		if err != nil {
+			r.done = true
+			return 0, errors.Join(ErrorOpenFile, err)
+		}
+		r.file = file
+	}
+	n, err := r.file.Read(b)
+	if err != nil {
+		r.close()
+	}
+	return n, err
+}
+
+func (r *lazyFileReader) close() {
+	r.done = true
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+)
+
+func newConcatParser(t *testing.T, options ...Option) *Parser {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, filename := range []string{"a.txt", "b.txt"} {
+		part, err := writer.CreateFormFile("files", filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(bytes.Repeat([]byte(filename[:1]), 1024))
+	}
+	writer.Close()
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return New(r, nil, 0, options...)
+}
+
+func TestConcatFiles(t *testing.T) {
+	reader, err := newConcatParser(t).ConcatFiles("files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 2048 || data[0] != 'a' || data[2047] != 'b' {
+		t.Fatalf("unexpected concatenated data of %d bytes", len(data))
+	}
+}
+
+func TestConcatFilesCloseReleasesOpenFile(t *testing.T) {
+	reader, err := newConcatParser(t).ConcatFiles("files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reader.Read(make([]byte, 16)); err != nil {
+		t.Fatal(err)
+	}
+	files := reader.(*concatReader).files
+	if files[0].file == nil {
+		t.Fatal("expected the first file to be open while reading")
+	}
+	reader.Close()
+	for i, file := range files {
+		if file.file != nil {
+			t.Fatalf("file %d still open after Close", i)
+		}
+	}
+}
+
+func TestConcatFilesValidatesFilenames(t *testing.T) {
+	invalid := errors.New("invalid name")
+	p := newConcatParser(t, WithFilenameValidator(func(name string) error {
+		if name == "b.txt" {
+			return invalid
+		}
+		return nil
+	}))
+	if _, err := p.ConcatFiles("files"); !errors.Is(err, ErrorInvalidFilename) {
+		t.Fatalf("expected ErrorInvalidFilename, got %v", err)
+	}
+}
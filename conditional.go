@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	headerIfModifiedSince = "If-Modified-Since"
+	headerIfNoneMatch     = "If-None-Match"
+)
+
+func (p *Parser) ConditionalRequest() (ifModifiedSince time.Time, ifNoneMatch []string, err error) {
+	defer p.finish("ConditionalRequest", p.start(), &err)
+	if value := strings.TrimSpace(p.r.Header.Get(headerIfModifiedSince)); len(value) > 0 {
+		ifModifiedSince, err = http.ParseTime(value)
+		if err != nil {
+			return time.Time{}, nil, fmt.Errorf("%w %q to time: %w", ErrorConvertValue, value, err)
+		}
+	}
+	for _, value := range p.r.Header.Values(headerIfNoneMatch) {
+		ifNoneMatch = append(ifNoneMatch, parseETags(value)...)
+	}
+	return ifModifiedSince, ifNoneMatch, nil
+}
+
+func (p *Parser) MustConditionalRequest() (time.Time, []string) {
+	ifModifiedSince, ifNoneMatch, err := p.ConditionalRequest()
+	if err != nil {
+		p.fail(err)
+	}
+	return ifModifiedSince, ifNoneMatch
+}
+
+// parseETags splits a list of entity tags, keeping the quotes and weak prefix, and the * wildcard.
+func parseETags(value string) []string {
+	etags := make([]string, 0)
+	for {
+		value = strings.TrimLeft(value, " \t,")
+		if len(value) == 0 {
+			return etags
+		}
+		start := 0
+		if strings.HasPrefix(value, "W/") {
+			start = 2
+		}
+		end := strings.IndexByte(value[start:], ',')
+		if strings.HasPrefix(value[start:], `"`) {
+			if closing := strings.IndexByte(value[start+1:], '"'); closing >= 0 {
+				end = closing + 2
+			}
+		}
+		if end < 0 {
+			end = len(value) - start
+		}
+		etags = append(etags, strings.TrimSpace(value[:start+end]))
+		value = value[start+end:]
+	}
+}
@@ -0,0 +1,311 @@
+package parser
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	
+	"github.com/creamsensation/util"
+)
+
+func (p *Parser) convertValue(src string, target any) error {
+	if p.trimSpace {
+		src = strings.TrimSpace(src)
+	}
+	if err := p.convertRawValue(src, target); err != nil {
+		return fmt.Errorf("%w %q to %s: %w", ErrorConvertValue, src, targetKind(target), err)
+	}
+	return nil
+}
+
+func (p *Parser) convertRawValue(src string, target any) error {
+	if unmarshaler, ok := p.jsonUnmarshaler(target); ok {
+		quoted, err := json.Marshal(src)
+		if err != nil {
+			return err
+		}
+		return unmarshaler.UnmarshalJSON(quoted)
+	}
+	switch t := target.(type) {
+	case *big.Int:
+		if _, ok := t.SetString(src, 10); !ok {
+			return ErrorInvalidBigNumber
+		}
+		return nil
+	case **big.Int:
+		*t = new(big.Int)
+		return p.convertRawValue(src, *t)
+	case *big.Float:
+		if _, ok := t.SetString(src); !ok {
+			return ErrorInvalidBigNumber
+		}
+		return nil
+	case **big.Float:
+		*t = new(big.Float)
+		return p.convertRawValue(src, *t)
+	case *net.IP:
+		ip := net.ParseIP(src)
+		if ip == nil {
+			return ErrorInvalidIP
+		}
+		*t = ip
+		return nil
+	case *netip.Addr:
+		addr, err := netip.ParseAddr(src)
+		if err != nil {
+			return err
+		}
+		*t = addr
+		return nil
+	case *netip.Prefix:
+		prefix, err := netip.ParsePrefix(src)
+		if err != nil {
+			return err
+		}
+		*t = prefix
+		return nil
+	case *time.Time:
+		parsed, err := p.parseTimeValue(src)
+		if err != nil {
+			return err
+		}
+		*t = parsed
+		return nil
+	case *time.Duration:
+		d, err := p.parseDuration(src)
+		if err != nil {
+			return err
+		}
+		*t = d
+		return nil
+	case *[]byte:
+		data, err := decodeBase64(src)
+		if err != nil {
+			return err
+		}
+		*t = data
+		return nil
+	}
+	tv := reflect.ValueOf(target)
+	if tv.Kind() != reflect.Ptr {
+		return util.ConvertValue(src, target)
+	}
+	switch elem := tv.Elem(); elem.Kind() {
+	case reflect.Ptr:
+		value := reflect.New(elem.Type().Elem())
+		if err := p.convertRawValue(src, value.Interface()); err != nil {
+			return err
+		}
+		elem.Set(value)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(p.normalizeNumber(src), elem.Type().Bits())
+		if err != nil {
+			return err
+		}
+		elem.SetFloat(f)
+		return nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(src, 10, elem.Type().Bits())
+		if err != nil {
+			return err
+		}
+		elem.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(src, 10, elem.Type().Bits())
+		if err != nil {
+			return err
+		}
+		elem.SetUint(n)
+		return nil
+	case reflect.Int, reflect.Bool, reflect.String:
+		if elem.Type().PkgPath() == "" {
+			return util.ConvertValue(src, target)
+		}
+		converted := reflect.New(namedBaseTypes[elem.Kind()])
+		if err := util.ConvertValue(src, converted.Interface()); err != nil {
+			return err
+		}
+		elem.Set(converted.Elem().Convert(elem.Type()))
+		return nil
+	}
+	return util.ConvertValue(src, target)
+}
+
+func (p *Parser) convertSlice(src []string, target any) error {
+	if p.trimSpace {
+		trimmed := make([]string, len(src))
+		for i, item := range src {
+			trimmed[i] = strings.TrimSpace(item)
+		}
+		src = trimmed
+	}
+	if tv := reflect.ValueOf(target); tv.Kind() == reflect.Ptr && tv.Elem().Kind() == reflect.Slice && tv.Elem().Type().Elem() == timeType {
+		src = p.splitTimeList(src)
+	}
+	if tv := reflect.ValueOf(target); p.strictSliceConversion && tv.Kind() == reflect.Ptr && tv.Elem().Kind() == reflect.Slice {
+		return p.convertSliceStrict(src, tv.Elem())
+	}
+	if tv := reflect.ValueOf(target); tv.Kind() == reflect.Ptr && tv.Elem().Kind() == reflect.Slice && convertsLocally(tv.Elem().Type().Elem()) {
+		slice := reflect.MakeSlice(tv.Elem().Type(), len(src), len(src))
+		for i, item := range src {
+			if err := p.convertRawValue(item, slice.Index(i).Addr().Interface()); err != nil {
+				return fmt.Errorf("%w %q to %s: %w", ErrorConvertValue, item, targetKind(target), err)
+			}
+		}
+		tv.Elem().Set(slice)
+		return nil
+	}
+	if tv := reflect.ValueOf(target); tv.Kind() == reflect.Ptr && tv.Elem().Kind() == reflect.Slice && isFloatKind(tv.Elem().Type().Elem().Kind()) {
+		normalized := make([]string, len(src))
+		for i, item := range src {
+			normalized[i] = p.normalizeNumber(item)
+		}
+		src = normalized
+	}
+	if err := util.ConvertSlice(src, target); err != nil {
+		return fmt.Errorf("%w %q to %s: %w", ErrorConvertValue, src, targetKind(target), err)
+	}
+	return nil
+}
+
+func (p *Parser) convertSliceStrict(src []string, target reflect.Value) error {
+	slice := reflect.MakeSlice(target.Type(), len(src), len(src))
+	errs := make([]error, 0)
+	for i, item := range src {
+		elem := slice.Index(i).Addr().Interface()
+		if err := p.convertRawValue(item, elem); err != nil {
+			errs = append(errs, fmt.Errorf("%w %q at index %d to %s: %w", ErrorConvertValue, item, i, targetKind(elem), err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	target.Set(slice)
+	return nil
+}
+
+// splitTimeList splits comma separated dates such as ?on=2024-01-01,2024-02-01, unless the time layout has a comma.
+func (p *Parser) splitTimeList(src []string) []string {
+	if strings.Contains(p.timeLayout, ",") {
+		return src
+	}
+	result := make([]string, 0, len(src))
+	for _, item := range src {
+		for _, part := range strings.Split(item, ",") {
+			if p.trimSpace {
+				part = strings.TrimSpace(part)
+			}
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+func decodeBase64(src string) ([]byte, error) {
+	src = strings.ReplaceAll(src, " ", "+")
+	encoding := base64.StdEncoding
+	if strings.ContainsAny(src, "-_") {
+		encoding = base64.URLEncoding
+	}
+	if !strings.HasSuffix(src, "=") {
+		encoding = encoding.WithPadding(base64.NoPadding)
+	}
+	return encoding.DecodeString(src)
+}
+
+func (p *Parser) normalizeNumber(src string) string {
+	if len(p.thousandsSeparator) > 0 {
+		src = strings.ReplaceAll(src, p.thousandsSeparator, "")
+	}
+	if len(p.decimalSeparator) > 0 && p.decimalSeparator != "." {
+		src = strings.ReplaceAll(src, p.decimalSeparator, ".")
+	}
+	return src
+}
+
+var namedBaseTypes = map[reflect.Kind]reflect.Type{
+	reflect.Int:    reflect.TypeOf(0),
+	reflect.Bool:   reflect.TypeOf(false),
+	reflect.String: reflect.TypeOf(""),
+}
+
+// jsonUnmarshaler returns targets that only know how to decode json, which then receive the value as a json string.
+func (p *Parser) jsonUnmarshaler(target any) (json.Unmarshaler, bool) {
+	if !p.jsonUnmarshalerFallback {
+		return nil, false
+	}
+	if _, ok := target.(encoding.TextUnmarshaler); ok {
+		return nil, false
+	}
+	unmarshaler, ok := target.(json.Unmarshaler)
+	return unmarshaler, ok
+}
+
+var timeValueLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+func (p *Parser) parseTimeValue(src string) (time.Time, error) {
+	if len(p.timeLayout) > 0 {
+		return time.Parse(p.timeLayout, src)
+	}
+	var err error
+	for _, layout := range timeValueLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, src); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+func (p *Parser) parseDuration(src string) (time.Duration, error) {
+	if p.defaultDurationUnit > 0 {
+		if n, err := strconv.ParseFloat(src, 64); err == nil {
+			return time.Duration(n * float64(p.defaultDurationUnit)), nil
+		}
+	}
+	return time.ParseDuration(src)
+}
+
+// convertsLocally reports slice elements util.ConvertSlice cannot assign, which are converted one by one instead.
+func convertsLocally(t reflect.Type) bool {
+	if len(t.PkgPath()) > 0 {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Bool, reflect.String, reflect.Float64:
+		return false
+	}
+	return true
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func targetKind(target any) string {
+	t := reflect.TypeOf(target)
+	if t == nil {
+		return "nil"
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice:
+		return "[]" + t.Elem().String()
+	case reflect.Struct:
+		return t.String()
+	}
+	return t.Kind().String()
+}
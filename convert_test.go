@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQueryTimeSlice(t *testing.T) {
+	expected := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, rawQuery := range []string{"on=2024-01-01&on=2024-02-01", "on=2024-01-01,2024-02-01"} {
+		var dates []time.Time
+		if err := New(httptest.NewRequest("GET", "/?"+rawQuery, nil), nil, 1).Query("on", &dates); err != nil {
+			t.Fatalf("%s: %v", rawQuery, err)
+		}
+		if len(dates) != len(expected) || !dates[0].Equal(expected[0]) || !dates[1].Equal(expected[1]) {
+			t.Errorf("%s: unexpected dates %v", rawQuery, dates)
+		}
+	}
+}
+
+func TestQueryTimeSliceLayoutWithComma(t *testing.T) {
+	p := New(httptest.NewRequest("GET", "/?on=Mon,+01+Jan+2024", nil), nil, 1, WithTimeLayout("Mon, 02 Jan 2006"))
+	var dates []time.Time
+	if err := p.Query("on", &dates); err != nil {
+		t.Fatal(err)
+	}
+	if len(dates) != 1 || !dates[0].Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected dates %v", dates)
+	}
+}
+
+func TestQueryTimeSliceError(t *testing.T) {
+	var dates []time.Time
+	err := New(httptest.NewRequest("GET", "/?on=2024-01-01,nope", nil), nil, 1).Query("on", &dates)
+	if !errors.Is(err, ErrorConvertValue) || !strings.Contains(err.Error(), "[]time.Time") {
+		t.Fatalf("expected conversion error naming []time.Time, got %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	
+	"github.com/creamsensation/util"
+)
+
+const (
+	csrfHeader = "X-CSRF-Token"
+	csrfField  = "csrf_token"
+)
+
+// CSRFToken returns the token from the X-CSRF-Token header, falling back to the csrf_token form field.
+// Comparing it against the session value is left to the caller.
+func (p *Parser) CSRFToken() (token string, err error) {
+	defer p.finish("CSRFToken", p.start(), &err)
+	if token = strings.TrimSpace(p.r.Header.Get(csrfHeader)); len(token) > 0 {
+		return token, nil
+	}
+	if p.mediaType() == mediaTypeForm || util.IsRequestMultipart(p.r) {
+		values, err := p.formValues()
+		if err != nil && !errors.Is(err, ErrorEmptyBody) {
+			return "", err
+		}
+		if token = strings.TrimSpace(values.Get(csrfField)); len(token) > 0 {
+			return token, nil
+		}
+	}
+	return "", ErrorMissingCSRFToken
+}
+
+func (p *Parser) MustCSRFToken() string {
+	token, err := p.CSRFToken()
+	if err != nil {
+		p.fail(err)
+	}
+	return token
+}
@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+)
+
+// CsvRows streams the body record by record. The header is passed as line 0.
+func (p *Parser) CsvRows(fn func(record []string, line int) error) (err error) {
+	defer p.finish("CsvRows", p.start(), &err)
+	var reader io.Reader
+	switch {
+	case len(p.bytes) > 0:
+		reader = bytes.NewReader(p.bytes)
+	case p.r.Body == nil:
+		return p.emptyBody()
+	default:
+		if reader, err = p.bodyReader(); err != nil {
+			return err
+		}
+	}
+	csvReader := csv.NewReader(reader)
+	for line := 0; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			if line == 0 {
+				return p.emptyBody()
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(record, line); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *Parser) MustCsvRows(fn func(record []string, line int) error) {
+	err := p.CsvRows(fn)
+	if err != nil {
+		p.fail(err)
+	}
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"net/http"
+	"sort"
+	
+	"github.com/creamsensation/util/constant/header"
+)
+
+type ParserSnapshot struct {
+	Method      string              `json:"method"`
+	Path        string              `json:"path"`
+	QueryKeys   []string            `json:"queryKeys"`
+	PathKeys    []string            `json:"pathKeys"`
+	Headers     map[string][]string `json:"headers"`
+	ContentType string              `json:"contentType"`
+	BodySize    int64               `json:"bodySize"`
+	FileNames   []string            `json:"fileNames"`
+}
+
+const redactedValue = "***"
+
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+	"X-Csrf-Token":        true,
+}
+
+// Debug summarizes the request for logging. The mux does not expose the route's wildcard names,
+// so pass the path keys to report, as with Everything.
+func (p *Parser) Debug(pathKeys ...string) ParserSnapshot {
+	snapshot := ParserSnapshot{
+		Method:      p.r.Method,
+		Path:        p.r.URL.Path,
+		QueryKeys:   make([]string, 0),
+		PathKeys:    make([]string, 0),
+		Headers:     make(map[string][]string),
+		ContentType: p.r.Header.Get(header.ContentType),
+		BodySize:    p.r.ContentLength,
+		FileNames:   make([]string, 0),
+	}
+	switch {
+	case len(p.bytes) > 0:
+		snapshot.BodySize = int64(len(p.bytes))
+	case p.buffer != nil:
+		snapshot.BodySize = int64(p.buffer.Len())
+	}
+	for key := range p.query() {
+		snapshot.QueryKeys = append(snapshot.QueryKeys, key)
+	}
+	sort.Strings(snapshot.QueryKeys)
+	for _, key := range pathKeys {
+		if len(p.r.PathValue(key)) > 0 {
+			snapshot.PathKeys = append(snapshot.PathKeys, key)
+		}
+	}
+	for key, values := range p.r.Header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			snapshot.Headers[key] = []string{redactedValue}
+			continue
+		}
+		snapshot.Headers[key] = values
+	}
+	if p.r.MultipartForm != nil {
+		for _, files := range p.r.MultipartForm.File {
+			for _, file := range files {
+				snapshot.FileNames = append(snapshot.FileNames, file.Filename)
+			}
+		}
+		sort.Strings(snapshot.FileNames)
+	}
+	return snapshot
+}
@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"io"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDebugPathKeysAndReplayedBodySize(t *testing.T) {
+	r := httptest.NewRequest("POST", "/users/7?b=1&a=2", io.MultiReader(strings.NewReader("payload")))
+	r.ContentLength = -1
+	r.SetPathValue("id", "7")
+	p := New(r, nil, 1, WithRequestBodyReplay())
+	if _, err := p.Text(); err != nil {
+		t.Fatal(err)
+	}
+	snapshot := p.Debug("id", "missing")
+	if !slices.Equal(snapshot.PathKeys, []string{"id"}) || !slices.Equal(snapshot.QueryKeys, []string{"a", "b"}) {
+		t.Fatalf("unexpected keys %v and %v", snapshot.PathKeys, snapshot.QueryKeys)
+	}
+	if snapshot.BodySize != int64(len("payload")) {
+		t.Fatalf("expected body size %d, got %d", len("payload"), snapshot.BodySize)
+	}
+}
@@ -1,12 +1,55 @@
 package parser
 
-import "errors"
+import (
+	"errors"
+	
+	"github.com/creamsensation/util"
+)
 
 var (
-	ErrorInvalidMultipart = errors.New("request has not multipart content type")
-	ErrorOpenFile         = errors.New("file cannot be opened")
-	ErrorReadData         = errors.New("cannot read data")
-	ErrorPointerTarget    = errors.New("target must be a pointer")
-	ErrorQueryMissing     = errors.New("query param is missing")
-	ErrorPathValueMissing = errors.New("path value is missing")
+	ErrorInvalidMultipart     = errors.New("request has not multipart content type")
+	ErrorOpenFile             = errors.New("file cannot be opened")
+	ErrorReadData             = errors.New("cannot read data")
+	ErrorPointerTarget        = util.ErrorPointerTarget
+	ErrorQueryMissing         = errors.New("query param is missing")
+	ErrorPathValueMissing     = errors.New("path value is missing")
+	ErrorUnsupportedMediaType = errors.New("unsupported media type")
+	ErrorDecompressedTooLarge = errors.New("decompressed data exceeds limit")
+	ErrorInvalidBigNumber     = errors.New("invalid big number")
+	ErrorTypeResolverMissing  = errors.New("type resolver is missing")
+	ErrorDiscriminatorMissing = errors.New("discriminator field is missing")
+	ErrorUnknownDiscriminator = errors.New("unknown discriminator value")
+	ErrorResolvedTypeMismatch = errors.New("resolved type does not match target")
+	ErrorConvertValue         = errors.New("cannot convert")
+	ErrorUnknownQueryParam    = errors.New("unknown query param")
+	ErrorInvalidTimeRange     = errors.New("invalid time range")
+	ErrorInvalidPagination    = errors.New("invalid pagination")
+	ErrorJsonTooDeep          = errors.New("json exceeds max depth")
+	ErrorTrailerMissing       = errors.New("trailer is missing")
+	ErrorUnknownXmlElement    = errors.New("unknown xml element")
+	ErrorTooManyQueryParams   = errors.New("too many query params")
+	ErrorInvalidFilename      = errors.New("invalid filename")
+	ErrorInvalidQueryIndex    = errors.New("invalid query index")
+	ErrorInvalidImage         = errors.New("invalid image data")
+	ErrorEmptyBody            = errors.New("request body is empty")
+	ErrorFileMissing          = errors.New("file is missing")
+	ErrorUnsupportedFileType  = errors.New("unsupported file type")
+	ErrorMalformedMultipart   = errors.New("malformed multipart body")
+	ErrorInvalidEnum          = errors.New("invalid enum value")
+	ErrorInvalidIP            = errors.New("invalid ip address")
+	ErrorHeaderTooLarge       = errors.New("header value too large")
+	ErrorMissingCSRFToken     = errors.New("missing csrf token")
+	ErrorUploadTooLarge       = errors.New("total upload size too large")
+	ErrorNotProtoMessage      = errors.New("target is not a proto message")
+	ErrorFileTypeMismatch     = errors.New("file type mismatch")
+	ErrorInvalidPathIndex     = errors.New("invalid path index")
+	ErrorInvalidRange         = errors.New("invalid range")
+	ErrorTrailingData         = errors.New("trailing data after json value")
+	ErrorInvalidCursor        = errors.New("invalid cursor")
+	ErrorTrailerTimeout       = errors.New("trailer timeout")
+	ErrorDuplicateQueryKey    = errors.New("duplicate query key")
+	ErrorTooManyFormFields    = errors.New("too many form fields")
+	ErrorNonStructTarget      = errors.New("target must point to a struct")
+	ErrorOutOfRange           = errors.New("value out of range")
+	ErrorInvalidJsonLine      = errors.New("invalid json on line")
 )
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	
+	"github.com/creamsensation/util"
+)
+
+func TestPointerTargetSentinel(t *testing.T) {
+	var value struct{}
+	cases := map[string]func(p *Parser) error{
+		"json value":   func(p *Parser) error { return p.Json(value) },
+		"json nil":     func(p *Parser) error { return p.Json(nil) },
+		"json layout":  func(p *Parser) error { return p.JsonWithTimeLayout(value, "2006-01-02") },
+		"xml strict":   func(p *Parser) error { return p.XmlStrict(value) },
+		"polymorphic":  func(p *Parser) error { return p.JsonPolymorphic(value, "type") },
+		"query struct": func(p *Parser) error { return p.QueryStruct(value) },
+	}
+	for name, call := range cases {
+		contentType := "application/json"
+		if strings.HasPrefix(name, "xml") {
+			contentType = "application/xml"
+		}
+		r := httptest.NewRequest("POST", "/", strings.NewReader("{}"))
+		r.Header.Set("Content-Type", contentType)
+		err := call(New(r, nil, 1))
+		if !errors.Is(err, ErrorPointerTarget) || !errors.Is(err, util.ErrorPointerTarget) {
+			t.Errorf("%s: expected pointer target error, got %v", name, err)
+		}
+	}
+}
+
+func TestNilTargetsDoNotPanic(t *testing.T) {
+	type target struct {
+		Name string `query:"name" form:"name"`
+	}
+	var typedNil *target
+	cases := map[string]func(p *Parser) error{
+		"cursor nil":          func(p *Parser) error { return p.Cursor(nil) },
+		"cursor typed nil":    func(p *Parser) error { return p.Cursor(typedNil) },
+		"form nil":            func(p *Parser) error { return p.Form(nil) },
+		"form typed nil":      func(p *Parser) error { return p.Form(typedNil) },
+		"query struct nil":    func(p *Parser) error { return p.QueryStruct(nil) },
+		"query struct typed":  func(p *Parser) error { return p.QueryStruct(typedNil) },
+		"parse all nil":       func(p *Parser) error { return p.ParseAll(nil) },
+		"parse all typed nil": func(p *Parser) error { return p.ParseAll(typedNil) },
+		"url nil":             func(p *Parser) error { return p.Url(nil) },
+		"url typed nil":       func(p *Parser) error { return p.Url(typedNil) },
+		"json lines nil":      func(p *Parser) error { return p.ParseFileJsonLines("file", nil) },
+	}
+	for name, call := range cases {
+		r := httptest.NewRequest("POST", "/?name=a&cursor=e30", strings.NewReader("name=a"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if err := call(New(r, nil, 1)); !errors.Is(err, ErrorPointerTarget) {
+			t.Errorf("%s: expected pointer target error, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+const defaultEventName = "message"
+
+// EventStream reads the body as server-sent event frames separated by blank lines and calls fn for every frame
+// carrying data. Multiple data lines are joined with a newline, a frame without an event field is a "message".
+func (p *Parser) EventStream(fn func(event string, data []byte) error) (err error) {
+	defer p.finish("EventStream", p.start(), &err)
+	var reader io.Reader
+	switch {
+	case len(p.bytes) > 0:
+		reader = bytes.NewReader(p.bytes)
+	case p.r.Body == nil:
+		return p.emptyBody()
+	default:
+		if reader, err = p.bodyReader(); err != nil {
+			return err
+		}
+	}
+	buffered := bufio.NewReader(reader)
+	var event string
+	var data []byte
+	hasData := false
+	dispatch := func() error {
+		defer func() {
+			event, data, hasData = "", nil, false
+		}()
+		if !hasData {
+			return nil
+		}
+		if len(event) == 0 {
+			event = defaultEventName
+		}
+		return fn(event, data)
+	}
+	for {
+		line, readErr := buffered.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 && readErr == nil {
+			if err := dispatch(); err != nil {
+				return err
+			}
+			continue
+		}
+		field, value, _ := bytes.Cut(line, []byte(":"))
+		value = bytes.TrimPrefix(value, []byte(" "))
+		switch string(field) {
+		case "event":
+			event = string(value)
+		case "data":
+			if hasData {
+				data = append(data, '\n')
+			}
+			data = append(data, value...)
+			hasData = true
+		}
+		if readErr == io.EOF {
+			return dispatch()
+		}
+	}
+}
+
+func (p *Parser) MustEventStream(fn func(event string, data []byte) error) {
+	err := p.EventStream(fn)
+	if err != nil {
+		p.fail(err)
+	}
+}
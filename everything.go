@@ -0,0 +1,57 @@
+package parser
+
+import "encoding/json"
+
+// Everything merges headers, query values, the given path values and a JSON body
+// into one map. Later sources override earlier ones: headers, query, path, body.
+func (p *Parser) Everything(pathKeys ...string) (merged map[string]any, err error) {
+	defer p.finish("Everything", p.start(), &err)
+	if err := p.checkQueryLimit(); err != nil {
+		return nil, err
+	}
+	result := make(map[string]any)
+	for key, values := range p.r.Header {
+		result[key] = flattenValues(values)
+	}
+	for key, values := range p.query() {
+		result[key] = flattenValues(values)
+	}
+	for _, key := range pathKeys {
+		if value := p.r.PathValue(key); len(value) > 0 {
+			result[key] = value
+		}
+	}
+	if !isJsonMediaType(p.mediaType()) {
+		return result, nil
+	}
+	data, err := p.readJsonBytes()
+	if err != nil {
+		return result, err
+	}
+	if len(data) == 0 {
+		return result, nil
+	}
+	body := make(map[string]any)
+	if err := json.Unmarshal(data, &body); err != nil {
+		return result, err
+	}
+	for key, value := range body {
+		result[key] = value
+	}
+	return result, nil
+}
+
+func (p *Parser) MustEverything(pathKeys ...string) map[string]any {
+	result, err := p.Everything(pathKeys...)
+	if err != nil {
+		p.fail(err)
+	}
+	return result
+}
+
+func flattenValues(values []string) any {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
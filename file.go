@@ -0,0 +1,36 @@
+package parser
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	
+	"gopkg.in/yaml.v3"
+)
+
+func (p *Parser) ParseFile(formKey string, target any) (err error) {
+	defer p.finish("ParseFile", p.start(), &err)
+	file, err := p.File(formKey)
+	if err != nil {
+		return err
+	}
+	if len(file.Key) == 0 {
+		return ErrorFileMissing
+	}
+	switch strings.ToLower(file.Suffix) {
+	case "json":
+		return json.Unmarshal(file.Data, target)
+	case "xml":
+		return xml.Unmarshal(file.Data, target)
+	case "yaml", "yml":
+		return yaml.Unmarshal(file.Data, target)
+	}
+	return ErrorUnsupportedFileType
+}
+
+func (p *Parser) MustParseFile(formKey string, target any) {
+	err := p.ParseFile(formKey, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"bytes"
+	"compress/gzip"
+	"mime/multipart"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+)
+
+func newUploadParser(t *testing.T, files map[string]string) *Parser {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, content := range files {
+		part, err := writer.CreateFormFile(name, name+".txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte(content))
+	}
+	writer.Close()
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return New(r, nil, 1)
+}
+
+func TestRepeatedFileCalls(t *testing.T) {
+	p := newUploadParser(t, map[string]string{"a": "first", "b": "second"})
+	for i := 0; i < 2; i++ {
+		file, err := p.File("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(file.Data) != "first" {
+			t.Fatalf("call %d: unexpected file %q", i, file.Data)
+		}
+		files, err := p.Files()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 2 || string(files[0].Data) != "first" || string(files[1].Data) != "second" {
+			t.Fatalf("call %d: unexpected files %+v", i, files)
+		}
+	}
+}
+
+func TestFileAfterMultipartParts(t *testing.T) {
+	p := newUploadParser(t, map[string]string{"a": "first"})
+	if _, err := p.MultipartParts(); err != nil {
+		t.Fatal(err)
+	}
+	file, err := p.File("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Data) != 0 || len(file.Name) != 0 {
+		t.Fatalf("expected no file once the body is streamed, got %+v", file)
+	}
+}
+
+func TestDuplicateFilesAndTotalUploadSize(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	content := bytes.Repeat([]byte("a"), 600)
+	for _, filename := range []string{"a.txt", "b.txt"} {
+		part, err := writer.CreateFormFile("file", filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(content)
+	}
+	writer.Close()
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	p := New(r, nil, 1, WithDeduplicateFiles(), WithMaxTotalUploadSize(1000))
+	for i := 0; i < 2; i++ {
+		files, err := p.Files()
+		if err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if len(files) != 1 || len(p.DuplicateFiles()) != 1 {
+			t.Fatalf("call %d: expected one file and one duplicate, got %d and %d", i, len(files), len(p.DuplicateFiles()))
+		}
+	}
+}
+
+func TestGzipPartWithoutLimit(t *testing.T) {
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	gz.Write([]byte("hello"))
+	gz.Close()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="f"; filename="f.txt"`)
+	header.Set("Content-Type", "text/plain")
+	header.Set("Content-Encoding", "gzip")
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(compressed.Bytes())
+	writer.Close()
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	file, err := New(r, nil, 0).File("f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(file.Data) != "hello" {
+		t.Fatalf("unexpected data %q", file.Data)
+	}
+}
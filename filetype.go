@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+	
+	"github.com/creamsensation/form"
+)
+
+const mediaTypeOctetStream = "application/octet-stream"
+
+func (p *Parser) checkFileType(m form.Multipart) error {
+	if !p.validateFileType || len(m.Suffix) == 0 {
+		return nil
+	}
+	expected := mime.TypeByExtension("." + strings.ToLower(m.Suffix))
+	if len(expected) == 0 {
+		return nil
+	}
+	if !fileTypesMatch(baseMediaType(m.Type), baseMediaType(expected)) {
+		return fmt.Errorf("%w: %s is %s", ErrorFileTypeMismatch, m.Name, m.Type)
+	}
+	return nil
+}
+
+// fileTypesMatch tolerates sniffed types that are less specific than the extension implies,
+// such as plain text for csv or a zip container for office documents.
+func fileTypesMatch(sniffed, expected string) bool {
+	switch sniffed {
+	case expected, mediaTypeOctetStream:
+		return true
+	case "text/plain":
+		return strings.HasPrefix(expected, "text/") || isJsonMediaType(expected) || isXmlMediaType(expected)
+	case mediaTypeTextXml:
+		return isXmlMediaType(expected)
+	case "application/zip":
+		return strings.HasPrefix(expected, "application/vnd.") || strings.HasSuffix(expected, "+zip")
+	}
+	return false
+}
+
+func baseMediaType(contentType string) string {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	return strings.TrimSpace(mediaType)
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	
+	"github.com/creamsensation/util"
+)
+
+func (p *Parser) Form(target any) (err error) {
+	defer p.finish("Form", p.start(), &err)
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(target)
+	if t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: got %s", ErrorNonStructTarget, t)
+	}
+	values, err := p.formValues()
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(target).Elem()
+	for i := 0; i < t.Elem().NumField(); i++ {
+		fieldInfo := t.Elem().Field(i)
+		formKey := formTag(fieldInfo)
+		if len(formKey) == 0 || !fieldInfo.IsExported() {
+			continue
+		}
+		fv, ok := values[formKey]
+		if !ok || len(fv) == 0 {
+			continue
+		}
+		if err := p.bindValues(fv, v.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Parser) MustForm(target any) {
+	err := p.Form(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) FormMap() (values url.Values, err error) {
+	defer p.finish("FormMap", p.start(), &err)
+	return p.formValues()
+}
+
+func (p *Parser) MustFormMap() url.Values {
+	values, err := p.FormMap()
+	if err != nil {
+		p.fail(err)
+	}
+	return values
+}
+
+func (p *Parser) FormValue(key string, target any) (err error) {
+	defer p.finish("FormValue", p.start(), &err)
+	values, err := p.formValues()
+	if err != nil {
+		return err
+	}
+	fv, ok := values[key]
+	if !ok || len(fv) == 0 {
+		return nil
+	}
+	return p.bindValues(fv, target)
+}
+
+func (p *Parser) MustFormValue(key string, target any) {
+	err := p.FormValue(key, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) formValues() (url.Values, error) {
+	if len(p.bytes) > 0 {
+		return url.ParseQuery(string(p.bytes))
+	}
+	if util.IsRequestMultipart(p.r) {
+		if err := p.parseMultipartForm(); err != nil {
+			return nil, err
+		}
+		return p.r.MultipartForm.Value, nil
+	}
+	if p.replayBody {
+		data, err := p.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			return url.Values{}, p.emptyBody()
+		}
+		return url.ParseQuery(string(data))
+	}
+	p.body()
+	if err := p.r.ParseForm(); err != nil {
+		return nil, err
+	}
+	if len(p.r.PostForm) == 0 {
+		return p.r.PostForm, p.emptyBody()
+	}
+	return p.r.PostForm, nil
+}
+
+func formTag(fieldInfo reflect.StructField) string {
+	if formKey, ok := fieldInfo.Tag.Lookup("form"); ok {
+		return formKey
+	}
+	jsonKey, _ := jsonTagName(fieldInfo)
+	return jsonKey
+}
@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFormRepeatedKeys(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", strings.NewReader("tag=a&tag=b&tag=c&name=x"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var target struct {
+		Tags []string `form:"tag"`
+		Name string   `form:"name"`
+	}
+	if err := New(r, nil, 1).Form(&target); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(target.Tags, []string{"a", "b", "c"}) || target.Name != "x" {
+		t.Fatalf("unexpected binding %+v", target)
+	}
+}
+
+func TestFormSingleValueIntoSlice(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", strings.NewReader("tag=a"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var target struct {
+		Tags []string `form:"tag"`
+	}
+	if err := New(r, nil, 1).Form(&target); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(target.Tags, []string{"a"}) {
+		t.Fatalf("unexpected binding %+v", target)
+	}
+}
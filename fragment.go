@@ -0,0 +1,51 @@
+package parser
+
+import (
+	"net/url"
+	"strings"
+)
+
+// QueryFragment binds a parameter from the URL fragment, taken from WithFragment or the request URL
+// when a proxy forwarded it.
+func (p *Parser) QueryFragment(key string, target any) (err error) {
+	defer p.finish("QueryFragment", p.start(), &err)
+	values, err := p.fragmentQuery()
+	if err != nil {
+		return err
+	}
+	fv, ok := values[key]
+	if !ok || len(fv) == 0 {
+		if p.requiredQuery {
+			return ErrorQueryMissing
+		}
+		return nil
+	}
+	return p.bindValues(fv, target)
+}
+
+func (p *Parser) MustQueryFragment(key string, target any) {
+	err := p.QueryFragment(key, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) fragmentQuery() (url.Values, error) {
+	if p.fragmentValues != nil {
+		return p.fragmentValues, nil
+	}
+	fragment := p.fragment
+	if len(fragment) == 0 && p.r.URL != nil {
+		fragment = p.r.URL.Fragment
+	}
+	fragment = strings.TrimPrefix(fragment, "#")
+	if err := p.checkPairLimit(fragment, false); err != nil {
+		return nil, err
+	}
+	values, err := url.ParseQuery(fragment)
+	if err != nil {
+		return nil, err
+	}
+	p.fragmentValues = values
+	return values, nil
+}
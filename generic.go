@@ -0,0 +1,51 @@
+package parser
+
+import "strings"
+
+func QuerySlice[T any](p *Parser, key string) (result []T, err error) {
+	defer p.finish("QuerySlice", p.start(), &err)
+	return querySlice[T](p, key, ",")
+}
+
+// QuerySliceSep splits values on sep instead of a comma, an empty sep only collects repeated keys.
+func QuerySliceSep[T any](p *Parser, key, sep string) (result []T, err error) {
+	defer p.finish("QuerySliceSep", p.start(), &err)
+	return querySlice[T](p, key, sep)
+}
+
+func querySlice[T any](p *Parser, key, sep string) ([]T, error) {
+	if err := p.checkQueryLimit(); err != nil {
+		return nil, err
+	}
+	values, ok := p.lookupQuery(key)
+	if !ok {
+		if p.requiredQuery {
+			return nil, ErrorQueryMissing
+		}
+		return []T{}, nil
+	}
+	result := make([]T, 0, len(values))
+	for _, value := range values {
+		items := []string{value}
+		if len(sep) > 0 {
+			items = strings.Split(value, sep)
+		}
+		for _, item := range items {
+			if len(item) == 0 {
+				continue
+			}
+			var t T
+			if err := p.convertValue(item, &t); err != nil {
+				return nil, err
+			}
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func PathParam[T any](p *Parser, key string) (result T, err error) {
+	defer p.finish("PathParam", p.start(), &err)
+	err = p.PathValue(key, &result)
+	return result, err
+}
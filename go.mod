@@ -5,6 +5,8 @@ go 1.22
 require (
 	github.com/creamsensation/form v0.1.4
 	github.com/creamsensation/util v0.1.1
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+func (p *Parser) Header(key string, target any) (err error) {
+	defer p.finish("Header", p.start(), &err)
+	values, err := p.headerValues(key)
+	if err != nil || len(values) == 0 {
+		return err
+	}
+	return p.bindValues(values, target)
+}
+
+func (p *Parser) MustHeader(key string, target any) {
+	err := p.Header(key, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) Cookie(name string, target any) (err error) {
+	defer p.finish("Cookie", p.start(), &err)
+	cookie, err := p.r.Cookie(name)
+	if errors.Is(err, http.ErrNoCookie) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return p.convertValue(cookie.Value, target)
+}
+
+func (p *Parser) MustCookie(name string, target any) {
+	err := p.Cookie(name, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+// ParseAll binds query, path, header and cookie tags, then decodes the body by its content type.
+// Later sources override earlier ones in that order.
+func (p *Parser) ParseAll(target any) (err error) {
+	defer p.finish("ParseAll", p.start(), &err)
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(target)
+	if t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: got %s", ErrorNonStructTarget, t)
+	}
+	if err := p.Url(target); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(target).Elem()
+	for i := 0; i < t.Elem().NumField(); i++ {
+		fieldInfo := t.Elem().Field(i)
+		if !fieldInfo.IsExported() {
+			continue
+		}
+		fieldValue := v.Field(i).Addr().Interface()
+		if err := p.processHeader(fieldInfo, fieldValue); err != nil {
+			return err
+		}
+		if err := p.processCookie(fieldInfo, fieldValue); err != nil {
+			return err
+		}
+	}
+	if len(p.mediaType()) == 0 {
+		return nil
+	}
+	return p.Body(target)
+}
+
+func (p *Parser) MustParseAll(target any) {
+	err := p.ParseAll(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) processHeader(fieldInfo reflect.StructField, fieldValue any) error {
+	headerKey := fieldInfo.Tag.Get("header")
+	if len(headerKey) == 0 {
+		return nil
+	}
+	values, err := p.headerValues(headerKey)
+	if err != nil || len(values) == 0 {
+		return err
+	}
+	return p.bindValues(values, fieldValue)
+}
+
+func (p *Parser) headerValues(key string) ([]string, error) {
+	values := p.r.Header.Values(key)
+	if p.maxHeaderValueLength <= 0 {
+		return values, nil
+	}
+	for _, value := range values {
+		if len(value) > p.maxHeaderValueLength {
+			return nil, fmt.Errorf("%w: %s", ErrorHeaderTooLarge, key)
+		}
+	}
+	return values, nil
+}
+
+func (p *Parser) processCookie(fieldInfo reflect.StructField, fieldValue any) error {
+	cookieName := fieldInfo.Tag.Get("cookie")
+	if len(cookieName) == 0 {
+		return nil
+	}
+	cookie, err := p.r.Cookie(cookieName)
+	if err != nil {
+		return nil
+	}
+	return p.convertValue(cookie.Value, fieldValue)
+}
@@ -0,0 +1,23 @@
+package parser
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseAllSkipsUnexportedFields(t *testing.T) {
+	r := httptest.NewRequest("GET", "/?name=a", nil)
+	r.Header.Set("X-Token", "b")
+	p := New(r, nil, 1)
+	var target struct {
+		Name   string `query:"name"`
+		Token  string `header:"X-Token"`
+		secret string
+	}
+	if err := p.ParseAll(&target); err != nil {
+		t.Fatal(err)
+	}
+	if target.Name != "a" || target.Token != "b" || target.secret != "" {
+		t.Fatalf("unexpected binding %+v", target)
+	}
+}
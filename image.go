@@ -0,0 +1,20 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	
+	"github.com/creamsensation/form"
+)
+
+func ImageInfo(m form.Multipart) (width, height int, format string, err error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(m.Data))
+	if err != nil {
+		return 0, 0, "", errors.Join(ErrorInvalidImage, err)
+	}
+	return config.Width, config.Height, format, nil
+}
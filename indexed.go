@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type SparseIndexPolicy int
+
+const (
+	// SparseIndexCompact binds rules[0] and rules[2] into a slice of length 2, keeping index order.
+	SparseIndexCompact SparseIndexPolicy = iota
+	// SparseIndexPreserve binds rules[0] and rules[2] into a slice of length 3 with a zero value at index 1.
+	SparseIndexPreserve
+)
+
+const maxQueryIndex = 10000
+
+func (p *Parser) bindQueryIndexed(queryKey string, fieldValue any) error {
+	if len(queryKey) == 0 {
+		return nil
+	}
+	prefix := p.normalizeQueryKey(queryKey) + "["
+	indexed := make(map[int]string)
+	for key, values := range p.query() {
+		if len(values) == 0 || !strings.HasPrefix(p.normalizeQueryKey(key), prefix) || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		index, err := strconv.Atoi(key[len(prefix) : len(key)-1])
+		if err != nil || index < 0 {
+			continue
+		}
+		if index > maxQueryIndex {
+			return ErrorInvalidQueryIndex
+		}
+		indexed[index] = values[0]
+	}
+	if len(indexed) == 0 {
+		return nil
+	}
+	indexes := make([]int, 0, len(indexed))
+	for index := range indexed {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	if p.sparseIndexPolicy == SparseIndexCompact {
+		values := make([]string, len(indexes))
+		for i, index := range indexes {
+			values[i] = indexed[index]
+		}
+		return p.convertSlice(values, fieldValue)
+	}
+	sv := reflect.ValueOf(fieldValue).Elem()
+	result := reflect.MakeSlice(sv.Type(), indexes[len(indexes)-1]+1, indexes[len(indexes)-1]+1)
+	for _, index := range indexes {
+		if err := p.convertValue(indexed[index], result.Index(index).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	sv.Set(result)
+	return nil
+}
@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+type indexedTestFilter struct {
+	Rules []int `query:"rules"`
+}
+
+func TestSparseIndexPolicy(t *testing.T) {
+	cases := map[SparseIndexPolicy][]int{
+		SparseIndexCompact:  {5, 7},
+		SparseIndexPreserve: {5, 0, 7},
+	}
+	for policy, expected := range cases {
+		r := httptest.NewRequest("GET", "/?rules[2]=7&rules[0]=5", nil)
+		var target indexedTestFilter
+		if err := New(r, nil, 1, WithSparseIndexPolicy(policy)).QueryStruct(&target); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(target.Rules, expected) {
+			t.Errorf("policy %d: expected %v, got %v", policy, expected, target.Rules)
+		}
+	}
+}
+
+func TestQueryIndexLimit(t *testing.T) {
+	r := httptest.NewRequest("GET", "/?rules[0]=1&rules[10001]=2", nil)
+	var target indexedTestFilter
+	if err := New(r, nil, 1, WithSparseIndexPolicy(SparseIndexPreserve)).QueryStruct(&target); !errors.Is(err, ErrorInvalidQueryIndex) {
+		t.Fatalf("expected ErrorInvalidQueryIndex, got %v", err)
+	}
+	r = httptest.NewRequest("GET", "/?rules[10000]=2", nil)
+	if err := New(r, nil, 1).QueryStruct(&target); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(target.Rules, []int{2}) {
+		t.Fatalf("unexpected binding %v", target.Rules)
+	}
+}
@@ -0,0 +1,26 @@
+package parser
+
+type Unmarshaler interface {
+	ParseFrom(p *Parser) error
+}
+
+func (p *Parser) Into(target any) (err error) {
+	defer p.finish("Into", p.start(), &err)
+	if u, ok := target.(Unmarshaler); ok {
+		return u.ParseFrom(p)
+	}
+	if err := p.Url(target); err != nil {
+		return err
+	}
+	if len(p.mediaType()) == 0 {
+		return nil
+	}
+	return p.Body(target)
+}
+
+func (p *Parser) MustInto(target any) {
+	err := p.Into(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	
+	"github.com/creamsensation/form"
+	"github.com/creamsensation/util"
+)
+
+const sniffLen = 512
+
+type MultipartIterator struct {
+	p      *Parser
+	reader *multipart.Reader
+}
+
+// MultipartParts streams the body, so File and Files called afterwards find no uploads.
+func (p *Parser) MultipartParts() (iterator *MultipartIterator, err error) {
+	defer p.finish("MultipartParts", p.start(), &err)
+	if !util.IsRequestMultipart(p.r) {
+		return nil, ErrorInvalidMultipart
+	}
+	p.body()
+	reader, err := p.r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	return &MultipartIterator{p: p, reader: reader}, nil
+}
+
+func (i *MultipartIterator) Next() (*multipart.Part, error) {
+	return i.reader.NextPart()
+}
+
+func (i *MultipartIterator) Read(part *multipart.Part) (form.Multipart, error) {
+	if err := i.p.validateFilename(part.FileName()); err != nil {
+		return form.Multipart{}, err
+	}
+	data, err := i.p.readMultipartData(part, part.Header)
+	if err != nil {
+		return form.Multipart{}, err
+	}
+	m := createMultipart(part.FormName(), part.FileName(), data)
+	if err := i.p.checkFileType(m); err != nil {
+		return form.Multipart{}, err
+	}
+	return m, nil
+}
+
+func (i *MultipartIterator) Sniff(part *multipart.Part) (string, io.Reader, error) {
+	reader := bufio.NewReaderSize(part, sniffLen)
+	head, err := reader.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		return "", nil, errors.Join(ErrorReadData, err)
+	}
+	return http.DetectContentType(head), reader, nil
+}
@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+func (p *Parser) readJsonBytes() ([]byte, error) {
+	data, err := p.readBytes()
+	if err != nil {
+		return nil, err
+	}
+	if p.maxJsonDepth > 0 && jsonDepthExceeds(data, p.maxJsonDepth) {
+		return nil, ErrorJsonTooDeep
+	}
+	return data, nil
+}
+
+func (p *Parser) decodeJson(r io.Reader, target any) error {
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(target); err != nil {
+		return err
+	}
+	if !p.disallowTrailingData {
+		return nil
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return ErrorTrailingData
+	}
+	return nil
+}
+
+func jsonDepthExceeds(data []byte, maxDepth int) bool {
+	depth := 0
+	inString := false
+	escaped := false
+	for _, b := range data {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case b == '\\':
+				escaped = true
+			case b == '"':
+				inString = false
+			}
+			continue
+		}
+		switch b {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+			if depth > maxDepth {
+				return true
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+	return false
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (p *Parser) JsonWithTimeLayout(target any, layout string) (err error) {
+	defer p.finish("JsonWithTimeLayout", p.start(), &err)
+	if err := p.checkJsonMediaType(); err != nil {
+		return err
+	}
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(target)
+	data, err := p.readJsonBytes()
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return p.emptyBody()
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw any
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+	data, err = json.Marshal(rewriteJsonTimes(raw, t, layout))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+func (p *Parser) MustJsonWithTimeLayout(target any, layout string) {
+	err := p.JsonWithTimeLayout(target, layout)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func rewriteJsonTimes(value any, t reflect.Type, layout string) any {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		if s, ok := value.(string); ok {
+			if parsed, err := time.Parse(layout, s); err == nil {
+				return parsed.Format(time.RFC3339Nano)
+			}
+		}
+		return value
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		if obj, ok := value.(map[string]any); ok {
+			rewriteJsonStructTimes(obj, t, layout)
+		}
+	case reflect.Slice, reflect.Array:
+		if items, ok := value.([]any); ok {
+			for i, item := range items {
+				items[i] = rewriteJsonTimes(item, t.Elem(), layout)
+			}
+		}
+	case reflect.Map:
+		if obj, ok := value.(map[string]any); ok {
+			for key, item := range obj {
+				obj[key] = rewriteJsonTimes(item, t.Elem(), layout)
+			}
+		}
+	}
+	return value
+}
+
+func rewriteJsonStructTimes(obj map[string]any, t reflect.Type, layout string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && len(name) == 0 {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && ft != timeType {
+				rewriteJsonStructTimes(obj, ft, layout)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+		for key, item := range obj {
+			if strings.EqualFold(key, name) {
+				obj[key] = rewriteJsonTimes(item, field.Type, layout)
+			}
+		}
+	}
+}
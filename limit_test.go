@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// chunkedRequest builds a request whose body has no known length, the way a
+// chunked upload arrives at a handler.
+func chunkedRequest(contentType string, body []byte) *http.Request {
+	r := httptest.NewRequest("POST", "/", io.MultiReader(bytes.NewReader(body)))
+	r.ContentLength = -1
+	r.Header.Set("Content-Type", contentType)
+	return r
+}
+
+func TestBodyLimitOnChunkedRequests(t *testing.T) {
+	large := strings.Repeat("a", 2<<20)
+	var upload bytes.Buffer
+	writer := multipart.NewWriter(&upload)
+	part, _ := writer.CreateFormFile("file", "file.txt")
+	part.Write([]byte(large))
+	writer.Close()
+	cases := map[string]struct {
+		contentType string
+		body        []byte
+		call        func(p *Parser) error
+	}{
+		"json": {"application/json", []byte(`"` + large + `"`), func(p *Parser) error {
+			var target string
+			return p.Json(&target)
+		}},
+		"text": {"text/plain", []byte(large), func(p *Parser) error {
+			_, err := p.Text()
+			return err
+		}},
+		"form": {"application/x-www-form-urlencoded", []byte("name=" + large), func(p *Parser) error {
+			var target struct {
+				Name string `form:"name"`
+			}
+			return p.Form(&target)
+		}},
+		"files": {writer.FormDataContentType(), upload.Bytes(), func(p *Parser) error {
+			_, err := p.Files()
+			return err
+		}},
+	}
+	for name, c := range cases {
+		p := New(chunkedRequest(c.contentType, c.body), nil, 1)
+		var maxBytesError *http.MaxBytesError
+		if err := c.call(p); !errors.As(err, &maxBytesError) {
+			t.Errorf("%s: expected body limit error, got %v", name, err)
+		}
+	}
+}
+
+func TestBodyLimitAllowsSmallChunkedRequests(t *testing.T) {
+	p := New(chunkedRequest("application/json", []byte(`{"name":"a"}`)), nil, 1)
+	var target struct {
+		Name string `json:"name"`
+	}
+	if err := p.Json(&target); err != nil || target.Name != "a" {
+		t.Fatalf("unexpected result %+v, %v", target, err)
+	}
+}
@@ -0,0 +1,23 @@
+package parser
+
+import "time"
+
+type Logger func(op string, err error, dur time.Duration)
+
+func (p *Parser) start() time.Time {
+	p.depth++
+	return time.Now()
+}
+
+func (p *Parser) finish(op string, start time.Time, err *error) {
+	p.depth--
+	if p.logger != nil {
+		p.logger(op, *err, time.Since(start))
+	}
+	if p.depth > 0 || p.errorTranslator == nil || *err == nil {
+		return
+	}
+	if translated := p.errorTranslator(*err); translated != nil {
+		*err = translated
+	}
+}
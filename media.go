@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"mime"
+	"strings"
+	
+	"github.com/creamsensation/util/constant/header"
+)
+
+const (
+	mediaTypeJson           = "application/json"
+	mediaTypeXml            = "application/xml"
+	mediaTypeTextXml        = "text/xml"
+	mediaTypeMultipartMixed = "multipart/mixed"
+	mediaTypeForm           = "application/x-www-form-urlencoded"
+	mediaTypeFormData       = "multipart/form-data"
+	mediaTypeProtobuf       = "application/x-protobuf"
+	mediaTypeProtobufAlt    = "application/protobuf"
+)
+
+func (p *Parser) mediaType() string {
+	contentType := p.r.Header.Get(header.ContentType)
+	if len(p.forceContentType) > 0 {
+		contentType = p.forceContentType
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+func isJsonMediaType(mediaType string) bool {
+	return mediaType == mediaTypeJson || strings.HasSuffix(mediaType, "+json")
+}
+
+func isXmlMediaType(mediaType string) bool {
+	return mediaType == mediaTypeXml || mediaType == mediaTypeTextXml || strings.HasSuffix(mediaType, "+xml")
+}
+
+func (p *Parser) checkJsonMediaType() error {
+	if p.enforceContentType && !isJsonMediaType(p.mediaType()) {
+		return ErrorUnsupportedMediaType
+	}
+	return nil
+}
+
+func (p *Parser) checkXmlMediaType() error {
+	if p.enforceContentType && !isXmlMediaType(p.mediaType()) {
+		return ErrorUnsupportedMediaType
+	}
+	return nil
+}
+
+func isProtobufMediaType(mediaType string) bool {
+	return mediaType == mediaTypeProtobuf || mediaType == mediaTypeProtobufAlt
+}
+
+func (p *Parser) checkProtobufMediaType() error {
+	if p.enforceContentType && !isProtobufMediaType(p.mediaType()) {
+		return ErrorUnsupportedMediaType
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+)
+
+func FuzzParseMultipartForm(f *testing.F) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("name", "value")
+	part, _ := writer.CreateFormFile("file", "file.txt")
+	part.Write([]byte("content"))
+	writer.Close()
+	f.Add(writer.Boundary(), body.Bytes(), false)
+	f.Add(writer.Boundary(), body.Bytes(), true)
+	f.Add(writer.Boundary(), body.Bytes()[:body.Len()/2], false)
+	f.Add("x", []byte("--x\r\nContent-Disposition: form-data; name=\"a\"\r\n\r\n"), false)
+	f.Add("", []byte("garbage"), false)
+	f.Add("x", []byte("--x\n0"), false)
+	f.Add("x", []byte("--x\n\n"), true)
+	f.Fuzz(func(t *testing.T, boundary string, data []byte, nested bool) {
+		r := httptest.NewRequest("POST", "/", bytes.NewReader(data))
+		r.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+		options := make([]Option, 0)
+		if nested {
+			options = append(options, WithNestedMultipart())
+		}
+		err := New(r, nil, 1, options...).parseMultipartForm()
+		if err == nil {
+			return
+		}
+		if isMalformedMultipart(err) && !errors.Is(err, ErrorMalformedMultipart) {
+			t.Fatalf("malformed body not reported as such: %v", err)
+		}
+		var maxBytesError *http.MaxBytesError
+		if !errors.Is(err, ErrorMalformedMultipart) &&
+			!errors.Is(err, ErrorInvalidMultipart) &&
+			!errors.Is(err, ErrorReadData) &&
+			!errors.Is(err, ErrorDecompressedTooLarge) &&
+			!errors.Is(err, multipart.ErrMessageTooLarge) &&
+			!errors.As(err, &maxBytesError) {
+			t.Fatalf("unclassified multipart error: %v", err)
+		}
+	})
+}
+
+func TestIsMalformedMultipart(t *testing.T) {
+	cases := map[error]bool{
+		nil:                           false,
+		io.EOF:                        true,
+		io.ErrUnexpectedEOF:           true,
+		http.ErrMissingBoundary:       true,
+		http.ErrNotMultipart:          true,
+		multipart.ErrMessageTooLarge:  false,
+		&http.MaxBytesError{Limit: 1}: false,
+		fmt.Errorf("multipart: NextPart: %w", errors.New("bufio: buffer full")): true,
+		textproto.ProtocolError("malformed MIME header line: 0"):                true,
+		errors.New("disk full"): false,
+	}
+	for err, expected := range cases {
+		if isMalformedMultipart(err) != expected {
+			t.Errorf("%v: expected %t", err, expected)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	
+	"github.com/creamsensation/util/constant/header"
+)
+
+// ParseFileJsonLines decodes an uploaded newline delimited json file into the slice target, one element per line.
+// Blank lines are skipped and decoding errors name the offending line.
+func (p *Parser) ParseFileJsonLines(formKey string, target any) (err error) {
+	defer p.finish("ParseFileJsonLines", p.start(), &err)
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	tv := reflect.ValueOf(target)
+	if tv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w: expected a pointer to a slice, got %T", ErrorPointerTarget, target)
+	}
+	reader, err := p.openFile(formKey)
+	if err != nil {
+		return err
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	slice := tv.Elem()
+	buffered := bufio.NewReader(reader)
+	for line := 1; ; line++ {
+		data, readErr := buffered.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return errors.Join(ErrorReadData, readErr)
+		}
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			elem := reflect.New(slice.Type().Elem())
+			if err := json.Unmarshal(data, elem.Interface()); err != nil {
+				return fmt.Errorf("%w %d: %w", ErrorInvalidJsonLine, line, err)
+			}
+			slice.Set(reflect.Append(slice, elem.Elem()))
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
+
+func (p *Parser) MustParseFileJsonLines(formKey string, target any) {
+	err := p.ParseFileJsonLines(formKey, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+// openFile streams the first file under formKey, reading it whole only when it is gzip encoded or nested.
+func (p *Parser) openFile(formKey string) (io.Reader, error) {
+	if len(p.bytes) > 0 {
+		return nil, ErrorFileMissing
+	}
+	if err := p.parseMultipartForm(); err != nil {
+		return nil, err
+	}
+	if p.nestedMultiparts != nil {
+		for _, m := range p.nestedMultiparts {
+			if m.Key == formKey {
+				return bytes.NewReader(m.Data), nil
+			}
+		}
+		return nil, ErrorFileMissing
+	}
+	files := p.r.MultipartForm.File[formKey]
+	if len(files) == 0 {
+		return nil, ErrorFileMissing
+	}
+	if err := p.validateFilename(files[0].Filename); err != nil {
+		return nil, err
+	}
+	if files[0].Header.Get(header.ContentEncoding) == "gzip" {
+		data, err := p.readMultipartFile(files[0])
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(data), nil
+	}
+	file, err := files[0].Open()
+	if err != nil {
+		return nil, errors.Join(ErrorOpenFile, err)
+	}
+	return file, nil
+}
@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	
+	"github.com/creamsensation/form"
+	"github.com/creamsensation/util/constant/header"
+)
+
+const maxMultipartDepth = 8
+
+// readNestedMultiparts streams the body through a multipart reader, flattening files of nested multiparts
+// into the result. Values of non-file parts replace the request's MultipartForm.
+func (p *Parser) readNestedMultiparts() ([]form.Multipart, error) {
+	p.body()
+	reader, err := p.r.MultipartReader()
+	if err != nil {
+		return nil, errors.Join(ErrorMalformedMultipart, err)
+	}
+	result := make([]form.Multipart, 0)
+	values := make(map[string][]string)
+	hashes := make(map[[sha256.Size]byte]bool)
+	if err := p.readNestedParts(reader, "", 0, hashes, values, &result); err != nil {
+		return nil, err
+	}
+	p.r.MultipartForm = &multipart.Form{Value: values, File: make(map[string][]*multipart.FileHeader)}
+	return result, nil
+}
+
+func (p *Parser) readNestedParts(
+	reader *multipart.Reader,
+	parentName string,
+	depth int,
+	hashes map[[sha256.Size]byte]bool,
+	values map[string][]string,
+	result *[]form.Multipart,
+) error {
+	if depth > maxMultipartDepth {
+		return ErrorMalformedMultipart
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Join(ErrorMalformedMultipart, err)
+		}
+		name := part.FormName()
+		if len(name) == 0 {
+			name = parentName
+		}
+		mediaType, params, _ := mime.ParseMediaType(part.Header.Get(header.ContentType))
+		if strings.HasPrefix(mediaType, "multipart/") {
+			if err := p.readNestedParts(multipart.NewReader(part, params["boundary"]), name, depth+1, hashes, values, result); err != nil {
+				return err
+			}
+			continue
+		}
+		filename := part.FileName()
+		if len(filename) == 0 {
+			data, err := io.ReadAll(part)
+			if isMalformedMultipart(err) {
+				return errors.Join(ErrorMalformedMultipart, err)
+			}
+			if err != nil {
+				return errors.Join(ErrorReadData, err)
+			}
+			values[name] = append(values[name], string(data))
+			continue
+		}
+		if err := p.validateFilename(filename); err != nil {
+			return err
+		}
+		data, err := p.readMultipartData(part, part.Header)
+		if isMalformedMultipart(err) {
+			return errors.Join(ErrorMalformedMultipart, err)
+		}
+		if err != nil {
+			return err
+		}
+		if p.isDuplicateFile(name, filename, data, hashes) {
+			continue
+		}
+		*result = append(*result, createMultipart(name, filename, data))
+	}
+}
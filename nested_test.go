@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+)
+
+func createNestedRequest(t *testing.T, mediaType string) *http.Request {
+	t.Helper()
+	var inner bytes.Buffer
+	innerWriter := multipart.NewWriter(&inner)
+	for _, file := range []struct{ name, data string }{{"a.txt", "first"}, {"b.txt", "second"}} {
+		part, err := innerWriter.CreatePart(textproto.MIMEHeader{
+			"Content-Disposition": {`file; filename="` + file.name + `"`},
+			"Content-Type":        {"text/plain"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte(file.data))
+	}
+	innerWriter.Close()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("title", "docs")
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="files"`},
+		"Content-Type":        {"multipart/mixed; boundary=" + innerWriter.Boundary()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(inner.Bytes())
+	writer.Close()
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", mediaType+"; boundary="+writer.Boundary())
+	return r
+}
+
+func TestNestedMultipartMixed(t *testing.T) {
+	p := New(createNestedRequest(t, "multipart/mixed"), nil, 1)
+	reader, err := p.ConcatFiles("files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "firstsecond" {
+		t.Fatalf("expected concatenated nested files, got %q", data)
+	}
+}
+
+func TestNestedMultipartFormData(t *testing.T) {
+	p := New(createNestedRequest(t, "multipart/form-data"), nil, 1, WithNestedMultipart())
+	files, err := p.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Name != "a.txt" || files[1].Name != "b.txt" || files[0].Key != "files" {
+		t.Fatalf("unexpected files %+v", files)
+	}
+	var title string
+	if err := p.FormValue("title", &title); err != nil {
+		t.Fatal(err)
+	}
+	if title != "docs" {
+		t.Fatalf("expected title docs, got %q", title)
+	}
+}
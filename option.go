@@ -0,0 +1,263 @@
+package parser
+
+import (
+	"reflect"
+	"time"
+)
+
+type Option func(p *Parser)
+
+func WithRequiredQuery() Option {
+	return func(p *Parser) {
+		p.requiredQuery = true
+	}
+}
+
+func WithRequestBodyReplay() Option {
+	return func(p *Parser) {
+		p.replayBody = true
+	}
+}
+
+func WithCaseInsensitiveQuery() Option {
+	return func(p *Parser) {
+		p.caseInsensitiveQuery = true
+	}
+}
+
+func WithTrimSpace() Option {
+	return func(p *Parser) {
+		p.trimSpace = true
+	}
+}
+
+func WithTypeResolver(discriminator string, resolver TypeResolver) Option {
+	return func(p *Parser) {
+		if p.typeResolvers == nil {
+			p.typeResolvers = make(map[string]TypeResolver)
+		}
+		p.typeResolvers[discriminator] = resolver
+	}
+}
+
+func WithRejectUnknownQuery() Option {
+	return func(p *Parser) {
+		p.rejectUnknownQuery = true
+	}
+}
+
+func WithMaxJsonDepth(depth int) Option {
+	return func(p *Parser) {
+		p.maxJsonDepth = depth
+	}
+}
+
+func WithLogger(logger Logger) Option {
+	return func(p *Parser) {
+		p.logger = logger
+	}
+}
+
+func WithDeduplicateFiles() Option {
+	return func(p *Parser) {
+		p.deduplicateFiles = true
+	}
+}
+
+func WithMaxQueryParams(n int) Option {
+	return func(p *Parser) {
+		p.maxQueryParams = n
+	}
+}
+
+func WithFilenameValidator(validator func(name string) error) Option {
+	return func(p *Parser) {
+		p.filenameValidator = validator
+	}
+}
+
+func WithEnforceContentType() Option {
+	return func(p *Parser) {
+		p.enforceContentType = true
+	}
+}
+
+func WithDecimalSeparator(separator string) Option {
+	return func(p *Parser) {
+		p.decimalSeparator = separator
+	}
+}
+
+func WithThousandsSeparator(separator string) Option {
+	return func(p *Parser) {
+		p.thousandsSeparator = separator
+	}
+}
+
+func WithTrustedProxies(proxies ...string) Option {
+	return func(p *Parser) {
+		for _, proxy := range proxies {
+			if prefix, ok := parseTrustedProxy(proxy); ok {
+				p.trustedProxies = append(p.trustedProxies, prefix)
+			}
+		}
+	}
+}
+
+func WithSparseIndexPolicy(policy SparseIndexPolicy) Option {
+	return func(p *Parser) {
+		p.sparseIndexPolicy = policy
+	}
+}
+
+func WithSemicolonQuerySeparator() Option {
+	return func(p *Parser) {
+		p.semicolonQuery = true
+	}
+}
+
+func WithRequireBody() Option {
+	return func(p *Parser) {
+		p.requireBody = true
+	}
+}
+
+func WithPanicHandler(handler func(err error)) Option {
+	return func(p *Parser) {
+		p.panicHandler = handler
+	}
+}
+
+func WithErrorTranslator(translator func(err error) error) Option {
+	return func(p *Parser) {
+		p.errorTranslator = translator
+	}
+}
+
+func WithMaxHeaderValueLength(n int) Option {
+	return func(p *Parser) {
+		p.maxHeaderValueLength = n
+	}
+}
+
+func WithJsonTagFallback() Option {
+	return func(p *Parser) {
+		p.jsonTagFallback = true
+	}
+}
+
+func WithMaxTotalUploadSize(bytes int64) Option {
+	return func(p *Parser) {
+		p.maxTotalUploadSize = bytes
+	}
+}
+
+func WithValidateFileType() Option {
+	return func(p *Parser) {
+		p.validateFileType = true
+	}
+}
+
+func WithBareFlagAsTrue() Option {
+	return func(p *Parser) {
+		p.bareFlagAsTrue = true
+	}
+}
+
+func WithStrictSliceConversion() Option {
+	return func(p *Parser) {
+		p.strictSliceConversion = true
+	}
+}
+
+func WithBeforeBind(hook func(fieldInfo reflect.StructField, fieldValue any)) Option {
+	return func(p *Parser) {
+		p.beforeBind = hook
+	}
+}
+
+func WithAfterBind(hook func(fieldInfo reflect.StructField, fieldValue any, err error)) Option {
+	return func(p *Parser) {
+		p.afterBind = hook
+	}
+}
+
+func WithQueryArrayStyle(style QueryArrayStyle) Option {
+	return func(p *Parser) {
+		p.queryArrayStyle = style
+	}
+}
+
+func WithDisallowTrailingData() Option {
+	return func(p *Parser) {
+		p.disallowTrailingData = true
+	}
+}
+
+func WithFragment(fragment string) Option {
+	return func(p *Parser) {
+		p.fragment = fragment
+	}
+}
+
+func WithForceContentType(mime string) Option {
+	return func(p *Parser) {
+		p.forceContentType = mime
+	}
+}
+
+func WithDefaultDurationUnit(unit time.Duration) Option {
+	return func(p *Parser) {
+		p.defaultDurationUnit = unit
+	}
+}
+
+func WithRejectDuplicateQueryKeys() Option {
+	return func(p *Parser) {
+		p.rejectDuplicateQueryKeys = true
+	}
+}
+
+func WithJsonUnmarshalerFallback() Option {
+	return func(p *Parser) {
+		p.jsonUnmarshalerFallback = true
+	}
+}
+
+func WithMaxFormFields(n int) Option {
+	return func(p *Parser) {
+		p.maxFormFields = n
+	}
+}
+
+func WithLimit(limit int64) Option {
+	return func(p *Parser) {
+		p.limit = limit
+	}
+}
+
+func WithTimeLayout(layout string) Option {
+	return func(p *Parser) {
+		p.timeLayout = layout
+	}
+}
+
+func WithRequestIDHeaders(headers ...string) Option {
+	return func(p *Parser) {
+		p.requestIDHeaders = headers
+	}
+}
+
+func WithGenerateRequestID() Option {
+	return func(p *Parser) {
+		p.generateRequestID = true
+	}
+}
+
+// WithNestedMultipart streams multipart/form-data bodies instead of using ParseMultipartForm, so parts that are
+// themselves multipart are unpacked. Files are then held in memory and never spill to disk.
+func WithNestedMultipart() Option {
+	return func(p *Parser) {
+		p.nestedMultipart = true
+	}
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+)
+
+const (
+	pageQueryKey   = "page"
+	limitQueryKey  = "limit"
+	offsetQueryKey = "offset"
+	cursorQueryKey = "cursor"
+)
+
+func (p *Parser) Page(defaultLimit, maxLimit int) (limit, offset int, err error) {
+	defer p.finish("Page", p.start(), &err)
+	if err := p.checkQueryLimit(); err != nil {
+		return 0, 0, err
+	}
+	limit, ok, err := p.queryInt(limitQueryKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !ok {
+		limit = defaultLimit
+	}
+	if limit < 0 {
+		return 0, 0, ErrorInvalidPagination
+	}
+	limit = max(1, min(limit, maxLimit))
+	offset, ok, err = p.queryInt(offsetQueryKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ok {
+		if offset < 0 {
+			return 0, 0, ErrorInvalidPagination
+		}
+		return limit, offset, nil
+	}
+	page, ok, err := p.queryInt(pageQueryKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !ok || page == 0 {
+		page = 1
+	}
+	if page < 0 || page-1 > math.MaxInt/limit {
+		return 0, 0, ErrorInvalidPagination
+	}
+	return limit, (page - 1) * limit, nil
+}
+
+// Cursor decodes the base64 json ?cursor= into target and leaves it untouched when the param is absent.
+func (p *Parser) Cursor(target any) (err error) {
+	defer p.finish("Cursor", p.start(), &err)
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	if err := p.checkQueryLimit(); err != nil {
+		return err
+	}
+	values, ok := p.lookupQuery(cursorQueryKey)
+	if !ok || len(values) == 0 || len(strings.TrimSpace(values[0])) == 0 {
+		return nil
+	}
+	data, err := decodeBase64(strings.TrimSpace(values[0]))
+	if err != nil {
+		return errors.Join(ErrorInvalidCursor, err)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return errors.Join(ErrorInvalidCursor, err)
+	}
+	return nil
+}
+
+func (p *Parser) MustCursor(target any) {
+	err := p.Cursor(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) queryInt(key string) (int, bool, error) {
+	values, ok := p.lookupQuery(key)
+	if !ok || len(values) == 0 || len(values[0]) == 0 {
+		return 0, false, nil
+	}
+	var value int
+	if err := p.convertValue(values[0], &value); err != nil {
+		return 0, true, err
+	}
+	return value, true, nil
+}
@@ -0,0 +1,22 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPage(t *testing.T) {
+	p := New(httptest.NewRequest("GET", "/?page=3&limit=20", nil), nil, 1)
+	limit, offset, err := p.Page(10, 100)
+	if err != nil || limit != 20 || offset != 40 {
+		t.Fatalf("expected 20, 40, got %d, %d, %v", limit, offset, err)
+	}
+}
+
+func TestPageOverflow(t *testing.T) {
+	p := New(httptest.NewRequest("GET", "/?page=9223372036854775807&limit=100", nil), nil, 1)
+	if _, _, err := p.Page(10, 100); !errors.Is(err, ErrorInvalidPagination) {
+		t.Fatalf("expected ErrorInvalidPagination, got %v", err)
+	}
+}
@@ -1,51 +1,206 @@
 package parser
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/netip"
+	"net/textproto"
+	"net/url"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 	
 	"github.com/creamsensation/form"
 	"github.com/creamsensation/util"
+	"github.com/creamsensation/util/constant/header"
+	"google.golang.org/protobuf/proto"
 )
 
 type Parse interface {
+	Body(target any) error
+	Into(target any) error
 	Query(key string, target any) error
+	QueryExists(key string) bool
+	QueryRaw(key string) (string, bool)
 	PathValue(key string, target any) error
+	PathValueExists(key string) bool
+	PathValueRaw(key string) (string, bool)
+	PathValueRawEscaped(key string) (string, bool)
+	Trailer(key string, target any) error
+	TrailerWait(key string, timeout time.Duration, target any) error
+	Header(key string, target any) error
+	Cookie(name string, target any) error
 	File(filename string) (form.Multipart, error)
 	Files(filesnames ...string) ([]form.Multipart, error)
+	FilesBySuffix(suffixes ...string) ([]form.Multipart, error)
+	DuplicateFiles() []form.Multipart
+	MultipartSpill() (bool, []string, error)
+	MultipartParts() (*MultipartIterator, error)
+	ConcatFiles(formKey string) (io.ReadCloser, error)
+	FilesAsZip() (io.ReadCloser, error)
+	ParseFile(formKey string, target any) error
+	ParseFileJsonLines(formKey string, target any) error
 	Json(target any) error
+	JsonPatch(target any) (map[string]bool, error)
+	JsonPolymorphic(target any, discriminator string) error
+	JsonWithTimeLayout(target any, layout string) error
 	Text() (string, error)
+	TextReader() (io.Reader, error)
+	TextRedacted(patterns ...*regexp.Regexp) (string, error)
+	CsvRows(fn func(record []string, line int) error) error
+	EventStream(fn func(event string, data []byte) error) error
 	Xml(target any) error
+	Proto(msg proto.Message) error
+	Form(target any) error
+	FormMap() (url.Values, error)
+	FormValue(key string, target any) error
+	XmlStrict(target any) error
 	Url(target any) error
+	UrlVerbose(target any) (map[string]string, error)
+	QueryStruct(target any) error
+	QueryFragment(key string, target any) error
+	QueryPairs(key, pairSep, kvSep string) (map[string]string, error)
+	ParseAll(target any) error
+	TimeRange(fromKey, toKey string, layout string) (time.Time, time.Time, error)
+	QueryTime(key, layout string) (time.Time, error)
+	QueryDate(key string) (time.Time, error)
+	Page(defaultLimit, maxLimit int) (limit, offset int, err error)
+	Cursor(target any) error
+	Everything(pathKeys ...string) (map[string]any, error)
+	Debug(pathKeys ...string) ParserSnapshot
+	Method() string
+	Path() string
+	RemoteIP() string
+	RequestID() string
+	CSRFToken() (string, error)
+	ConditionalRequest() (time.Time, []string, error)
+	RangeHeader() ([]HttpRange, error)
 	Many() Parse
+	Clone(options ...Option) *Parser
+	Reset()
 	
+	MustBody(target any)
+	MustInto(target any)
 	MustQuery(key string, target any)
 	MustPathValue(key string, target any)
+	MustTrailer(key string, target any)
+	MustTrailerWait(key string, timeout time.Duration, target any)
+	MustHeader(key string, target any)
+	MustCookie(name string, target any)
 	MustFile(filename string) form.Multipart
 	MustFiles(filesnames ...string) []form.Multipart
+	MustFilesBySuffix(suffixes ...string) []form.Multipart
+	MustConcatFiles(formKey string) io.ReadCloser
+	MustFilesAsZip() io.ReadCloser
+	MustParseFile(formKey string, target any)
+	MustParseFileJsonLines(formKey string, target any)
 	MustJson(target any)
+	MustJsonPatch(target any) map[string]bool
+	MustJsonPolymorphic(target any, discriminator string)
+	MustJsonWithTimeLayout(target any, layout string)
 	MustText() string
+	MustTextReader() io.Reader
+	MustTextRedacted(patterns ...*regexp.Regexp) string
+	MustCsvRows(fn func(record []string, line int) error)
+	MustEventStream(fn func(event string, data []byte) error)
 	MustXml(target any)
+	MustProto(msg proto.Message)
+	MustForm(target any)
+	MustFormMap() url.Values
+	MustFormValue(key string, target any)
+	MustXmlStrict(target any)
 	MustUrl(target any)
+	MustUrlVerbose(target any) map[string]string
+	MustQueryStruct(target any)
+	MustQueryFragment(key string, target any)
+	MustQueryPairs(key, pairSep, kvSep string) map[string]string
+	MustParseAll(target any)
+	MustQueryTime(key, layout string) time.Time
+	MustQueryDate(key string) time.Time
+	MustEverything(pathKeys ...string) map[string]any
+	MustCursor(target any)
+	MustCSRFToken() string
+	MustConditionalRequest() (time.Time, []string)
+	MustRangeHeader() []HttpRange
 }
 
 type Parser struct {
-	r     *http.Request
-	bytes []byte
-	limit int64
-	many  bool
+	r                        *http.Request
+	bytes                    []byte
+	limit                    int64
+	many                     bool
+	requiredQuery            bool
+	replayBody               bool
+	caseInsensitiveQuery     bool
+	queryIndex               map[string]string
+	trimSpace                bool
+	typeResolvers            map[string]TypeResolver
+	bodyLimited              bool
+	rejectUnknownQuery       bool
+	maxJsonDepth             int
+	logger                   Logger
+	deduplicateFiles         bool
+	maxQueryParams           int
+	filenameValidator        func(name string) error
+	enforceContentType       bool
+	decimalSeparator         string
+	thousandsSeparator       string
+	trustedProxies           []netip.Prefix
+	sparseIndexPolicy        SparseIndexPolicy
+	semicolonQuery           bool
+	queryValues              url.Values
+	requireBody              bool
+	nestedMultiparts         []form.Multipart
+	panicHandler             func(err error)
+	errorTranslator          func(err error) error
+	maxHeaderValueLength     int
+	jsonTagFallback          bool
+	maxTotalUploadSize       int64
+	buffer                   *bytes.Buffer
+	validateFileType         bool
+	bareFlagAsTrue           bool
+	strictSliceConversion    bool
+	beforeBind               func(fieldInfo reflect.StructField, fieldValue any)
+	afterBind                func(fieldInfo reflect.StructField, fieldValue any, err error)
+	queryArrayStyle          QueryArrayStyle
+	disallowTrailingData     bool
+	fragment                 string
+	fragmentValues           url.Values
+	forceContentType         string
+	defaultDurationUnit      time.Duration
+	rejectDuplicateQueryKeys bool
+	jsonUnmarshalerFallback  bool
+	maxFormFields            int
+	timeLayout               string
+	requestIDHeaders         []string
+	generateRequestID        bool
+	requestID                string
+	nestedMultipart          bool
+	depth                    int
+	duplicateFiles           []form.Multipart
 }
 
-func New(r *http.Request, defaultBytes []byte, limit int64) *Parser {
-	return &Parser{
+func New(r *http.Request, defaultBytes []byte, limit int64, options ...Option) *Parser {
+	p := &Parser{
 		r:     r,
 		bytes: defaultBytes,
 		limit: limit,
 	}
+	for _, option := range options {
+		option(p)
+	}
+	return p
 }
 
 func (p *Parser) Many() Parse {
@@ -53,18 +208,60 @@ func (p *Parser) Many() Parse {
 	return p
 }
 
-func (p *Parser) Query(key string, target any) error {
-	q := p.r.URL.Query()
-	qv, ok := q[key]
+func (p *Parser) Body(target any) (err error) {
+	defer p.finish("Body", p.start(), &err)
+	mediaType := p.mediaType()
+	switch {
+	case isJsonMediaType(mediaType):
+		return p.Json(target)
+	case isXmlMediaType(mediaType):
+		return p.Xml(target)
+	case mediaType == mediaTypeForm || mediaType == mediaTypeFormData:
+		return p.Form(target)
+	case isProtobufMediaType(mediaType):
+		msg, ok := target.(proto.Message)
+		if !ok {
+			return ErrorNotProtoMessage
+		}
+		return p.Proto(msg)
+	}
+	return ErrorUnsupportedMediaType
+}
+
+func (p *Parser) MustBody(target any) {
+	err := p.Body(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *Parser) Query(key string, target any) (err error) {
+	defer p.finish("Query", p.start(), &err)
+	if err := p.checkQueryLimit(); err != nil {
+		return err
+	}
+	qv, ok := p.lookupQuery(key)
 	if !ok {
+		if p.requiredQuery {
+			return ErrorQueryMissing
+		}
+		return nil
+	}
+	if !p.many {
+		if err := p.checkDuplicateQuery(key, qv, target); err != nil {
+			return err
+		}
+	}
+	if p.bindBareFlag(qv, target) {
 		return nil
 	}
+	qv = p.splitQueryArray(qv, target)
 	n := len(qv)
-	if !p.many && n == 1 {
-		return util.ConvertValue(qv[0], target)
+	if !p.many && n == 1 && !isSliceTarget(target) {
+		return p.convertValue(qv[0], target)
 	}
-	if p.many || n > 1 {
-		return util.ConvertSlice(qv, target)
+	if p.many || n > 0 {
+		return p.convertSlice(qv, target)
 	}
 	return nil
 }
@@ -72,80 +269,234 @@ func (p *Parser) Query(key string, target any) error {
 func (p *Parser) MustQuery(key string, target any) {
 	err := p.Query(key, target)
 	if err != nil {
-		panic(err)
+		p.fail(err)
+	}
+}
+
+func (p *Parser) QueryExists(key string) bool {
+	_, ok := p.lookupQuery(key)
+	return ok
+}
+
+func (p *Parser) QueryRaw(key string) (string, bool) {
+	qv, ok := p.lookupQuery(key)
+	if !ok || len(qv) == 0 {
+		return "", false
 	}
+	return qv[0], true
 }
 
-func (p *Parser) PathValue(key string, target any) error {
+// PathValue binds the segment as decoded by http.ServeMux, so %2F arrives as / and %20 as a space.
+// The value is not decoded again; use PathValueRawEscaped for the form sent by the client.
+func (p *Parser) PathValue(key string, target any) (err error) {
+	defer p.finish("PathValue", p.start(), &err)
 	pathValue := p.r.PathValue(key)
 	if len(pathValue) == 0 {
 		return ErrorPathValueMissing
 	}
-	return util.ConvertValue(pathValue, target)
+	return p.convertValue(pathValue, target)
 }
 
 func (p *Parser) MustPathValue(key string, target any) {
 	err := p.PathValue(key, target)
 	if err != nil {
-		panic(err)
+		p.fail(err)
+	}
+}
+
+func (p *Parser) PathValueExists(key string) bool {
+	return len(p.r.PathValue(key)) > 0
+}
+
+func (p *Parser) PathValueRaw(key string) (string, bool) {
+	pathValue := p.r.PathValue(key)
+	return pathValue, len(pathValue) > 0
+}
+
+// PathValueRawEscaped returns the still percent-encoded segments that decode to the path value.
+func (p *Parser) PathValueRawEscaped(key string) (string, bool) {
+	pathValue := p.r.PathValue(key)
+	if len(pathValue) == 0 {
+		return "", false
+	}
+	segments := strings.Split(strings.TrimPrefix(p.r.URL.EscapedPath(), "/"), "/")
+	for i := range segments {
+		for j := i + 1; j <= len(segments); j++ {
+			escaped := strings.Join(segments[i:j], "/")
+			if decoded, err := url.PathUnescape(escaped); err == nil && decoded == pathValue {
+				return escaped, true
+			}
+		}
+	}
+	return pathValue, true
+}
+
+func (p *Parser) Url(target any) (err error) {
+	defer p.finish("Url", p.start(), &err)
+	return p.bindUrl(target, nil)
+}
+
+func (p *Parser) MustUrl(target any) {
+	err := p.Url(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+// UrlVerbose binds like Url and reports for each query or path tagged field whether its value came from
+// "query", "path" or was left at its "default".
+func (p *Parser) UrlVerbose(target any) (sources map[string]string, err error) {
+	defer p.finish("UrlVerbose", p.start(), &err)
+	sources = make(map[string]string)
+	err = p.bindUrl(target, sources)
+	return sources, err
+}
+
+func (p *Parser) MustUrlVerbose(target any) map[string]string {
+	sources, err := p.UrlVerbose(target)
+	if err != nil {
+		p.fail(err)
 	}
+	return sources
 }
 
-func (p *Parser) Url(target any) error {
+func (p *Parser) bindUrl(target any, sources map[string]string) error {
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
 	t := reflect.TypeOf(target)
-	if t.Kind() != reflect.Ptr {
-		return util.ErrorPointerTarget
+	if t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: got %s", ErrorNonStructTarget, t)
+	}
+	if err := p.checkQueryLimit(); err != nil {
+		return err
 	}
 	v := reflect.ValueOf(target).Elem()
+	consumed := make(map[string]bool)
 	for i := 0; i < t.Elem().NumField(); i++ {
 		fieldInfo := t.Elem().Field(i)
+		if !fieldInfo.IsExported() {
+			continue
+		}
 		fieldValue := v.Field(i).Addr().Interface()
-		if err := p.processQuery(fieldInfo, fieldValue); err != nil {
-			return err
+		if p.beforeBind != nil {
+			p.beforeBind(fieldInfo, fieldValue)
 		}
-		if err := p.processPathValue(fieldInfo, fieldValue); err != nil {
+		err := p.bindUrlField(fieldInfo, fieldValue)
+		if p.afterBind != nil {
+			p.afterBind(fieldInfo, fieldValue, err)
+		}
+		if err != nil {
 			return err
 		}
+		if queryKey, ok := p.queryTag(fieldInfo); ok {
+			consumed[p.normalizeQueryKey(queryKey)] = true
+		}
+		if sources != nil {
+			p.recordSource(fieldInfo, sources)
+		}
+	}
+	if p.rejectUnknownQuery {
+		return p.checkUnknownQuery(consumed)
 	}
 	return nil
 }
 
-func (p *Parser) MustUrl(target any) {
-	err := p.Url(target)
-	if err != nil {
-		panic(err)
+func (p *Parser) bindUrlField(fieldInfo reflect.StructField, fieldValue any) error {
+	queryKey, _ := p.queryTag(fieldInfo)
+	if err := p.processQuery(queryKey, fieldInfo, fieldValue); err != nil {
+		return err
+	}
+	if err := p.processPathValue(fieldInfo, fieldValue); err != nil {
+		return err
 	}
+	return p.processPathIndex(fieldInfo, fieldValue)
 }
 
-func (p *Parser) Text() (string, error) {
-	if len(p.bytes) > 0 {
-		return string(p.bytes), nil
+func (p *Parser) recordSource(fieldInfo reflect.StructField, sources map[string]string) {
+	queryKey, hasQuery := p.queryTag(fieldInfo)
+	pathKey, hasPath := fieldInfo.Tag.Lookup("path")
+	_, hasPathIndex := fieldInfo.Tag.Lookup("pathindex")
+	if !hasQuery && !hasPath && !hasPathIndex {
+		return
 	}
-	if p.r.Body == nil {
-		return "", nil
+	sources[fieldInfo.Name] = "default"
+	if _, exists := p.lookupQuery(queryKey); hasQuery && exists {
+		sources[fieldInfo.Name] = "query"
+	}
+	if hasPath && p.r.PathValue(pathKey) != "" {
+		sources[fieldInfo.Name] = "path"
+	}
+	if index, err := strconv.Atoi(fieldInfo.Tag.Get("pathindex")); err == nil {
+		if segment, ok := p.pathSegment(index); ok && segment != "" {
+			sources[fieldInfo.Name] = "path"
+		}
+	}
+}
+
+func (p *Parser) Text() (text string, err error) {
+	defer p.finish("Text", p.start(), &err)
+	bytes, err := p.readBytes()
+	if err == nil && len(bytes) == 0 {
+		return "", p.emptyBody()
 	}
-	bytes, err := io.ReadAll(p.r.Body)
 	return string(bytes), err
 }
 
 func (p *Parser) MustText() string {
 	r, err := p.Text()
 	if err != nil {
-		panic(err)
+		p.fail(err)
 	}
 	return r
 }
 
-func (p *Parser) Json(target any) error {
+func (p *Parser) TextReader() (reader io.Reader, err error) {
+	defer p.finish("TextReader", p.start(), &err)
 	if len(p.bytes) > 0 {
-		return json.Unmarshal(p.bytes, target)
+		return bytes.NewReader(p.bytes), nil
 	}
 	if p.r.Body == nil {
-		return nil
+		return http.NoBody, p.emptyBody()
+	}
+	return p.bodyReader()
+}
+
+func (p *Parser) MustTextReader() io.Reader {
+	reader, err := p.TextReader()
+	if err != nil {
+		p.fail(err)
+	}
+	return reader
+}
+
+func (p *Parser) Json(target any) (err error) {
+	defer p.finish("Json", p.start(), &err)
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	if err := p.checkJsonMediaType(); err != nil {
+		return err
+	}
+	if len(p.bytes) > 0 || p.replayBody || p.maxJsonDepth > 0 {
+		data, err := p.readJsonBytes()
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return p.emptyBody()
+		}
+		if p.disallowTrailingData {
+			return p.decodeJson(bytes.NewReader(data), target)
+		}
+		return json.Unmarshal(data, target)
 	}
-	err := json.NewDecoder(p.r.Body).Decode(target)
+	if p.r.Body == nil {
+		return p.emptyBody()
+	}
+	err = p.decodeJson(p.body(), target)
 	if err == io.EOF {
-		return nil
+		return p.emptyBody()
 	}
 	return err
 }
@@ -153,32 +504,82 @@ func (p *Parser) Json(target any) error {
 func (p *Parser) MustJson(target any) {
 	err := p.Json(target)
 	if err != nil {
-		panic(err)
+		p.fail(err)
 	}
 }
 
-func (p *Parser) Xml(value any) error {
-	if len(p.bytes) > 0 {
-		return xml.Unmarshal(p.bytes, value)
+func (p *Parser) JsonPatch(target any) (present map[string]bool, err error) {
+	defer p.finish("JsonPatch", p.start(), &err)
+	if err := p.checkJsonMediaType(); err != nil {
+		return nil, err
+	}
+	bytes, err := p.readJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+	present = make(map[string]bool)
+	if len(bytes) == 0 {
+		return present, p.emptyBody()
+	}
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bytes, &raw); err != nil {
+		return nil, err
+	}
+	for key := range raw {
+		present[key] = true
+	}
+	return present, json.Unmarshal(bytes, target)
+}
+
+func (p *Parser) MustJsonPatch(target any) map[string]bool {
+	present, err := p.JsonPatch(target)
+	if err != nil {
+		p.fail(err)
+	}
+	return present
+}
+
+func (p *Parser) Xml(value any) (err error) {
+	defer p.finish("Xml", p.start(), &err)
+	if err := checkPointerTarget(value); err != nil {
+		return err
+	}
+	if err := p.checkXmlMediaType(); err != nil {
+		return err
+	}
+	if len(p.bytes) > 0 || p.replayBody {
+		data, err := p.readBytes()
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return p.emptyBody()
+		}
+		return xml.Unmarshal(data, value)
 	}
 	if p.r.Body == nil {
-		return nil
+		return p.emptyBody()
 	}
-	return xml.NewDecoder(p.r.Body).Decode(value)
+	err = xml.NewDecoder(p.body()).Decode(value)
+	if err == io.EOF && p.requireBody {
+		return ErrorEmptyBody
+	}
+	return err
 }
 
 func (p *Parser) MustXml(target any) {
 	err := p.Xml(target)
 	if err != nil {
-		panic(err)
+		p.fail(err)
 	}
 }
 
-func (p *Parser) File(filename string) (form.Multipart, error) {
+func (p *Parser) File(filename string) (file form.Multipart, err error) {
+	defer p.finish("File", p.start(), &err)
 	if len(p.bytes) > 0 {
 		return form.Multipart{}, nil
 	}
-	err := p.parseMultipartForm()
+	err = p.parseMultipartForm()
 	if err != nil {
 		return form.Multipart{}, err
 	}
@@ -195,16 +596,18 @@ func (p *Parser) File(filename string) (form.Multipart, error) {
 func (p *Parser) MustFile(filename string) form.Multipart {
 	file, err := p.File(filename)
 	if err != nil {
-		panic(err)
+		p.fail(err)
 	}
 	return file
 }
 
-func (p *Parser) Files(filesname ...string) ([]form.Multipart, error) {
+// Files returns uploaded files ordered by field name, and by their position in the body within one field.
+func (p *Parser) Files(filesname ...string) (files []form.Multipart, err error) {
+	defer p.finish("Files", p.start(), &err)
 	if len(p.bytes) > 0 {
 		return []form.Multipart{}, nil
 	}
-	err := p.parseMultipartForm()
+	err = p.parseMultipartForm()
 	if err != nil {
 		return []form.Multipart{}, err
 	}
@@ -218,62 +621,343 @@ func (p *Parser) Files(filesname ...string) ([]form.Multipart, error) {
 func (p *Parser) MustFiles(filesnames ...string) []form.Multipart {
 	files, err := p.Files(filesnames...)
 	if err != nil {
-		panic(err)
+		p.fail(err)
+	}
+	return files
+}
+
+func (p *Parser) readBytes() ([]byte, error) {
+	if len(p.bytes) > 0 {
+		return p.bytes, nil
+	}
+	if p.r.Body == nil {
+		return nil, nil
+	}
+	if p.replayBody {
+		return p.readReplayBytes()
+	}
+	return io.ReadAll(p.body())
+}
+
+func (p *Parser) FilesBySuffix(suffixes ...string) (files []form.Multipart, err error) {
+	defer p.finish("FilesBySuffix", p.start(), &err)
+	if len(p.bytes) > 0 {
+		return []form.Multipart{}, nil
+	}
+	err = p.parseMultipartForm()
+	if err != nil {
+		return []form.Multipart{}, err
+	}
+	return p.filterMultiparts(
+		func(_, filename string) bool {
+			suffix := util.GetFilenameSuffix(filename)
+			for _, s := range suffixes {
+				if strings.EqualFold(suffix, s) {
+					return true
+				}
+			}
+			return false
+		},
+	)
+}
+
+func (p *Parser) MustFilesBySuffix(suffixes ...string) []form.Multipart {
+	files, err := p.FilesBySuffix(suffixes...)
+	if err != nil {
+		p.fail(err)
 	}
 	return files
 }
 
+func (p *Parser) DuplicateFiles() []form.Multipart {
+	return p.duplicateFiles
+}
+
 func (p *Parser) createMultiparts(filename ...string) ([]form.Multipart, error) {
 	var fn string
 	if len(filename) > 0 {
 		fn = filename[0]
 	}
 	fnLen := len(fn)
+	return p.filterMultiparts(
+		func(name, _ string) bool {
+			return fnLen == 0 || name == fn
+		},
+	)
+}
+
+func (p *Parser) filterMultiparts(match func(name, filename string) bool) ([]form.Multipart, error) {
 	result := make([]form.Multipart, 0)
-	for name, files := range p.r.MultipartForm.File {
-		if fnLen > 0 && name != fn {
-			continue
+	var total int64
+	if p.nestedMultiparts != nil {
+		for _, m := range p.nestedMultiparts {
+			if !match(m.Key, m.Name) {
+				continue
+			}
+			if total += int64(len(m.Data)); p.exceedsTotalUploadSize(total) {
+				return result, ErrorUploadTooLarge
+			}
+			if err := p.checkFileType(m); err != nil {
+				return result, err
+			}
+			result = append(result, m)
 		}
-		for _, file := range files {
-			f, err := file.Open()
-			if err != nil {
-				return result, errors.Join(ErrorOpenFile, err)
+		return result, nil
+	}
+	p.duplicateFiles = nil
+	hashes := make(map[[sha256.Size]byte]bool)
+	names := make([]string, 0, len(p.r.MultipartForm.File))
+	for name := range p.r.MultipartForm.File {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, file := range p.r.MultipartForm.File[name] {
+			if !match(name, file.Filename) {
+				continue
+			}
+			if err := p.validateFilename(file.Filename); err != nil {
+				return result, err
 			}
-			data, err := io.ReadAll(f)
+			data, err := p.readMultipartFile(file)
 			if err != nil {
-				return result, errors.Join(ErrorReadData, err)
+				return result, err
+			}
+			if p.isDuplicateFile(name, file.Filename, data, hashes) {
+				continue
+			}
+			if total += int64(len(data)); p.exceedsTotalUploadSize(total) {
+				return result, ErrorUploadTooLarge
 			}
-			result = append(
-				result, form.Multipart{
-					Key:    name,
-					Name:   file.Filename,
-					Type:   http.DetectContentType(data),
-					Suffix: util.GetFilenameSuffix(file.Filename),
-					Data:   data,
-				},
-			)
+			m := createMultipart(name, file.Filename, data)
+			if err := p.checkFileType(m); err != nil {
+				return result, err
+			}
+			result = append(result, m)
 		}
 	}
 	return result, nil
 }
 
-func (p *Parser) parseMultipartForm() error {
+func (p *Parser) exceedsTotalUploadSize(total int64) bool {
+	return p.maxTotalUploadSize > 0 && total > p.maxTotalUploadSize
+}
+
+func (p *Parser) isDuplicateFile(key, filename string, data []byte, hashes map[[sha256.Size]byte]bool) bool {
+	if !p.deduplicateFiles {
+		return false
+	}
+	hash := sha256.Sum256(data)
+	if hashes[hash] {
+		p.duplicateFiles = append(p.duplicateFiles, form.Multipart{Key: key, Name: filename})
+		return true
+	}
+	hashes[hash] = true
+	return false
+}
+
+func createMultipart(key, filename string, data []byte) form.Multipart {
+	return form.Multipart{
+		Key:    key,
+		Name:   filename,
+		Type:   http.DetectContentType(data),
+		Suffix: util.GetFilenameSuffix(filename),
+		Data:   data,
+	}
+}
+
+func (p *Parser) validateFilename(filename string) error {
+	if p.filenameValidator == nil {
+		return nil
+	}
+	if err := p.filenameValidator(filename); err != nil {
+		return errors.Join(ErrorInvalidFilename, err)
+	}
+	return nil
+}
+
+func (p *Parser) readMultipartFile(file *multipart.FileHeader) ([]byte, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, errors.Join(ErrorOpenFile, err)
+	}
+	defer f.Close()
+	return p.readMultipartData(f, file.Header)
+}
+
+func (p *Parser) readMultipartData(r io.Reader, partHeader textproto.MIMEHeader) ([]byte, error) {
+	if partHeader.Get(header.ContentEncoding) != "gzip" {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, errors.Join(ErrorReadData, err)
+		}
+		return data, nil
+	}
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Join(ErrorReadData, err)
+	}
+	defer gz.Close()
+	if p.limit <= 0 {
+		data, err := io.ReadAll(gz)
+		if err != nil {
+			return nil, errors.Join(ErrorReadData, err)
+		}
+		return data, nil
+	}
+	limit := p.limit << 20
+	data, err := io.ReadAll(io.LimitReader(gz, limit+1))
+	if err != nil {
+		return nil, errors.Join(ErrorReadData, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrorDecompressedTooLarge
+	}
+	return data, nil
+}
+
+func (p *Parser) parseMultipartForm() (err error) {
+	if p.nestedMultiparts != nil {
+		return nil
+	}
+	if p.r.MultipartForm != nil {
+		return p.checkFormFields()
+	}
+	if p.mediaType() == mediaTypeMultipartMixed || (p.nestedMultipart && p.mediaType() == mediaTypeFormData) {
+		if p.nestedMultiparts, err = p.readNestedMultiparts(); err != nil {
+			return err
+		}
+		return p.checkFormFields()
+	}
 	if !util.IsRequestMultipart(p.r) {
 		return ErrorInvalidMultipart
 	}
-	return p.r.ParseMultipartForm(p.limit << 20)
+	p.body()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrorMalformedMultipart, r)
+		}
+	}()
+	err = p.r.ParseMultipartForm(p.limit << 20)
+	if isMalformedMultipart(err) {
+		return errors.Join(ErrorMalformedMultipart, err)
+	}
+	if err != nil {
+		return err
+	}
+	return p.checkFormFields()
+}
+
+func (p *Parser) checkFormFields() error {
+	if p.maxFormFields <= 0 || p.r.MultipartForm == nil {
+		return nil
+	}
+	fields := 0
+	for _, values := range p.r.MultipartForm.Value {
+		fields += len(values)
+	}
+	if fields > p.maxFormFields {
+		return ErrorTooManyFormFields
+	}
+	return nil
 }
 
-func (p *Parser) processQuery(fieldInfo reflect.StructField, fieldValue any) error {
-	queryKey := fieldInfo.Tag.Get("query")
-	q, exists := p.r.URL.Query()[queryKey]
+func isMalformedMultipart(err error) bool {
+	var maxBytesError *http.MaxBytesError
+	var protocolError textproto.ProtocolError
+	if err == nil || errors.As(err, &maxBytesError) || errors.Is(err, multipart.ErrMessageTooLarge) {
+		return false
+	}
+	return errors.Is(err, http.ErrMissingBoundary) ||
+		errors.Is(err, http.ErrNotMultipart) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &protocolError) ||
+		strings.HasPrefix(err.Error(), "multipart:")
+}
+
+func (p *Parser) emptyBody() error {
+	if p.requireBody {
+		return ErrorEmptyBody
+	}
+	return nil
+}
+
+func (p *Parser) body() io.Reader {
+	if p.limit > 0 && !p.bodyLimited && p.r.Body != nil {
+		p.r.Body = http.MaxBytesReader(nil, p.r.Body, p.limit<<20)
+		p.bodyLimited = true
+	}
+	return p.r.Body
+}
+
+func (p *Parser) processQuery(queryKey string, fieldInfo reflect.StructField, fieldValue any) error {
+	if err := p.bindQuery(queryKey, fieldValue); err != nil {
+		return err
+	}
+	if _, exists := p.lookupQuery(queryKey); !exists {
+		return nil
+	}
+	return validateField(fieldInfo, fieldValue)
+}
+
+func (p *Parser) bindQuery(queryKey string, fieldValue any) error {
+	if isMapTarget(fieldValue) {
+		return p.bindQueryMap(queryKey, fieldValue)
+	}
+	q, exists := p.lookupQuery(queryKey)
+	if (!exists || len(q) == 0) && isSliceTarget(fieldValue) {
+		return p.bindQueryIndexed(queryKey, fieldValue)
+	}
 	if !exists || len(q) == 0 {
 		return nil
 	}
-	if len(q) == 1 {
-		return util.ConvertValue(q[0], fieldValue)
+	if err := p.checkDuplicateQuery(queryKey, q, fieldValue); err != nil {
+		return err
+	}
+	if p.bindBareFlag(q, fieldValue) {
+		return nil
+	}
+	return p.bindValues(p.splitQueryArray(q, fieldValue), fieldValue)
+}
+
+// checkDuplicateQuery counts every spelling of the key when the query is case insensitive, so ?id=1&ID=2 is a duplicate.
+func (p *Parser) checkDuplicateQuery(key string, q []string, target any) error {
+	if !p.rejectDuplicateQueryKeys || isSliceTarget(target) {
+		return nil
+	}
+	count := len(q)
+	if p.caseInsensitiveQuery {
+		count = 0
+		for raw, values := range p.query() {
+			if strings.ToLower(raw) == strings.ToLower(key) {
+				count += len(values)
+			}
+		}
+	}
+	if count > 1 {
+		return fmt.Errorf("%w: %s", ErrorDuplicateQueryKey, key)
+	}
+	return nil
+}
+
+func (p *Parser) bindBareFlag(q []string, target any) bool {
+	if !p.bareFlagAsTrue || len(q) != 1 || len(strings.TrimSpace(q[0])) > 0 {
+		return false
+	}
+	tv := reflect.ValueOf(target)
+	if tv.Kind() != reflect.Ptr || tv.Elem().Kind() != reflect.Bool {
+		return false
 	}
-	return util.ConvertSlice(q, fieldValue)
+	tv.Elem().SetBool(true)
+	return true
+}
+
+func (p *Parser) bindValues(q []string, fieldValue any) error {
+	if len(q) == 1 && !isSliceTarget(fieldValue) {
+		return p.convertValue(q[0], fieldValue)
+	}
+	return p.convertSlice(q, fieldValue)
 }
 
 func (p *Parser) processPathValue(fieldInfo reflect.StructField, fieldValue any) error {
@@ -282,5 +966,68 @@ func (p *Parser) processPathValue(fieldInfo reflect.StructField, fieldValue any)
 	if pathValue == "" {
 		return nil
 	}
-	return util.ConvertValue(pathValue, fieldValue)
+	if err := p.convertValue(pathValue, fieldValue); err != nil {
+		return err
+	}
+	return validateField(fieldInfo, fieldValue)
+}
+
+// processPathIndex binds the zero based segment of the request path named by the pathindex tag.
+func (p *Parser) processPathIndex(fieldInfo reflect.StructField, fieldValue any) error {
+	indexTag, ok := fieldInfo.Tag.Lookup("pathindex")
+	if !ok {
+		return nil
+	}
+	index, err := strconv.Atoi(indexTag)
+	if err != nil || index < 0 {
+		return fmt.Errorf("%w: %s", ErrorInvalidPathIndex, indexTag)
+	}
+	segment, ok := p.pathSegment(index)
+	if !ok || segment == "" {
+		return nil
+	}
+	if err := p.convertValue(segment, fieldValue); err != nil {
+		return err
+	}
+	return validateField(fieldInfo, fieldValue)
+}
+
+func (p *Parser) pathSegment(index int) (string, bool) {
+	segments := strings.FieldsFunc(p.r.URL.EscapedPath(), func(r rune) bool {
+		return r == '/'
+	})
+	if index >= len(segments) {
+		return "", false
+	}
+	segment, err := url.PathUnescape(segments[index])
+	if err != nil {
+		return segments[index], true
+	}
+	return segment, true
+}
+
+func checkPointerTarget(target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("%w: expected a non-nil pointer, got %T", ErrorPointerTarget, target)
+	}
+	return nil
+}
+
+func isMapTarget(target any) bool {
+	t := reflect.TypeOf(target)
+	return t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Map
+}
+
+func isSliceTarget(target any) bool {
+	t := reflect.TypeOf(target)
+	return t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Slice && t.Elem().Elem().Kind() != reflect.Uint8
+}
+
+func (p *Parser) fail(err error) {
+	if p.panicHandler != nil {
+		p.panicHandler(err)
+		return
+	}
+	panic(err)
 }
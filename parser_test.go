@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPathValueEscaping(t *testing.T) {
+	cases := []struct {
+		path    string
+		value   string
+		escaped string
+	}{
+		{"/files/plain", "plain", "plain"},
+		{"/files/a%2Fb", "a/b", "a%2Fb"},
+		{"/files/a%20b", "a b", "a%20b"},
+		{"/files/a%252Fb", "a%2Fb", "a%252Fb"},
+		{"/rest/a%2Fb/c%20d", "a/b/c d", "a%2Fb/c%20d"},
+	}
+	for _, c := range cases {
+		var value, raw, escaped string
+		mux := http.NewServeMux()
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			p := New(r, nil, 1)
+			if err := p.PathValue("id", &value); err != nil {
+				t.Errorf("%s: %v", c.path, err)
+			}
+			raw, _ = p.PathValueRaw("id")
+			escaped, _ = p.PathValueRawEscaped("id")
+		}
+		mux.HandleFunc("/files/{id}", handler)
+		mux.HandleFunc("/rest/{id...}", handler)
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", c.path, nil))
+		if value != c.value || raw != c.value {
+			t.Errorf("%s: expected decoded %q, got %q and raw %q", c.path, c.value, value, raw)
+		}
+		if escaped != c.escaped {
+			t.Errorf("%s: expected escaped %q, got %q", c.path, c.escaped, escaped)
+		}
+	}
+}
+
+func TestPathValueMissing(t *testing.T) {
+	p := New(httptest.NewRequest("GET", "/", nil), nil, 1)
+	var value string
+	if err := p.PathValue("id", &value); !errors.Is(err, ErrorPathValueMissing) {
+		t.Fatalf("expected ErrorPathValueMissing, got %v", err)
+	}
+	if _, ok := p.PathValueRawEscaped("id"); ok {
+		t.Fatal("expected no escaped value")
+	}
+}
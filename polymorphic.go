@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+type TypeResolver func(value string) any
+
+func (p *Parser) JsonPolymorphic(target any, discriminator string) (err error) {
+	defer p.finish("JsonPolymorphic", p.start(), &err)
+	if err := p.checkJsonMediaType(); err != nil {
+		return err
+	}
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	tv := reflect.ValueOf(target)
+	if tv.Elem().Kind() != reflect.Interface {
+		return fmt.Errorf("%w: expected a pointer to an interface, got %T", ErrorPointerTarget, target)
+	}
+	resolve, ok := p.typeResolvers[discriminator]
+	if !ok {
+		return ErrorTypeResolverMissing
+	}
+	data, err := p.readJsonBytes()
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return p.emptyBody()
+	}
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	rawValue, ok := raw[discriminator]
+	if !ok {
+		return ErrorDiscriminatorMissing
+	}
+	var value string
+	if err := json.Unmarshal(rawValue, &value); err != nil {
+		return err
+	}
+	resolved := resolve(value)
+	if resolved == nil {
+		return ErrorUnknownDiscriminator
+	}
+	if err := json.Unmarshal(data, resolved); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(resolved)
+	if !rv.Type().AssignableTo(tv.Elem().Type()) {
+		return ErrorResolvedTypeMismatch
+	}
+	tv.Elem().Set(rv)
+	return nil
+}
+
+func (p *Parser) MustJsonPolymorphic(target any, discriminator string) {
+	err := p.JsonPolymorphic(target, discriminator)
+	if err != nil {
+		p.fail(err)
+	}
+}
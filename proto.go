@@ -0,0 +1,25 @@
+package parser
+
+import "google.golang.org/protobuf/proto"
+
+func (p *Parser) Proto(msg proto.Message) (err error) {
+	defer p.finish("Proto", p.start(), &err)
+	if err := p.checkProtobufMediaType(); err != nil {
+		return err
+	}
+	data, err := p.readBytes()
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return p.emptyBody()
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func (p *Parser) MustProto(msg proto.Message) {
+	err := p.Proto(msg)
+	if err != nil {
+		p.fail(err)
+	}
+}
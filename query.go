@@ -0,0 +1,236 @@
+package parser
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+func (p *Parser) QueryStruct(target any) (err error) {
+	defer p.finish("QueryStruct", p.start(), &err)
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(target)
+	if t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: got %s", ErrorNonStructTarget, t)
+	}
+	if err := p.checkQueryLimit(); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(target).Elem()
+	for i := 0; i < t.Elem().NumField(); i++ {
+		fieldInfo := t.Elem().Field(i)
+		if !fieldInfo.IsExported() {
+			continue
+		}
+		queryKey, ok := p.queryTag(fieldInfo)
+		if !ok {
+			queryKey = strings.ToLower(fieldInfo.Name)
+		}
+		if err := p.processQuery(queryKey, fieldInfo, v.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Parser) MustQueryStruct(target any) {
+	err := p.QueryStruct(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+// QueryPairs splits a value like name:asc,age:desc into a map, defaulting to "," between pairs and ":" within them.
+func (p *Parser) QueryPairs(key, pairSep, kvSep string) (pairs map[string]string, err error) {
+	defer p.finish("QueryPairs", p.start(), &err)
+	if len(pairSep) == 0 {
+		pairSep = ","
+	}
+	if len(kvSep) == 0 {
+		kvSep = ":"
+	}
+	pairs = make(map[string]string)
+	if err := p.checkQueryLimit(); err != nil {
+		return pairs, err
+	}
+	values, ok := p.lookupQuery(key)
+	if !ok {
+		if p.requiredQuery {
+			return pairs, ErrorQueryMissing
+		}
+		return pairs, nil
+	}
+	for _, value := range values {
+		for _, pair := range strings.Split(value, pairSep) {
+			k, v, _ := strings.Cut(pair, kvSep)
+			if p.trimSpace {
+				k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+			}
+			if len(k) == 0 {
+				continue
+			}
+			pairs[k] = v
+		}
+	}
+	return pairs, nil
+}
+
+func (p *Parser) MustQueryPairs(key, pairSep, kvSep string) map[string]string {
+	pairs, err := p.QueryPairs(key, pairSep, kvSep)
+	if err != nil {
+		p.fail(err)
+	}
+	return pairs
+}
+
+func (p *Parser) queryTag(fieldInfo reflect.StructField) (string, bool) {
+	if queryKey, ok := fieldInfo.Tag.Lookup("query"); ok || !p.jsonTagFallback {
+		return queryKey, ok
+	}
+	return jsonTagName(fieldInfo)
+}
+
+func jsonTagName(fieldInfo reflect.StructField) (string, bool) {
+	jsonKey, ok := fieldInfo.Tag.Lookup("json")
+	if !ok {
+		return "", false
+	}
+	jsonKey, _, _ = strings.Cut(jsonKey, ",")
+	if jsonKey == "-" {
+		return "", false
+	}
+	if len(jsonKey) == 0 {
+		jsonKey = fieldInfo.Name
+	}
+	return jsonKey, true
+}
+
+func (p *Parser) lookupQuery(key string) ([]string, bool) {
+	q := p.query()
+	if !p.caseInsensitiveQuery {
+		values, ok := q[key]
+		return values, ok
+	}
+	if p.queryIndex == nil {
+		p.queryIndex = createQueryIndex(p.queryPairs())
+	}
+	original, ok := p.queryIndex[strings.ToLower(key)]
+	if !ok {
+		return nil, false
+	}
+	values, ok := q[original]
+	return values, ok
+}
+
+func (p *Parser) checkQueryLimit() error {
+	return p.checkPairLimit(p.r.URL.RawQuery, p.semicolonQuery)
+}
+
+// checkPairLimit counts the raw pairs, so repeating one key cannot get past the limit.
+func (p *Parser) checkPairLimit(rawQuery string, semicolon bool) error {
+	if p.maxQueryParams <= 0 || len(rawQuery) == 0 {
+		return nil
+	}
+	pairs := 0
+	for _, pair := range strings.FieldsFunc(rawQuery, func(r rune) bool {
+		return r == '&' || (semicolon && r == ';')
+	}) {
+		if len(pair) > 0 {
+			pairs++
+		}
+	}
+	if pairs > p.maxQueryParams {
+		return ErrorTooManyQueryParams
+	}
+	return nil
+}
+
+func (p *Parser) query() url.Values {
+	if p.queryValues != nil {
+		return p.queryValues
+	}
+	if p.semicolonQuery {
+		p.queryValues, _ = url.ParseQuery(strings.ReplaceAll(p.r.URL.RawQuery, ";", "&"))
+		return p.queryValues
+	}
+	p.queryValues = p.r.URL.Query()
+	return p.queryValues
+}
+
+func (p *Parser) queryPairs() []string {
+	if p.semicolonQuery {
+		return strings.FieldsFunc(p.r.URL.RawQuery, func(r rune) bool { return r == '&' || r == ';' })
+	}
+	return strings.Split(p.r.URL.RawQuery, "&")
+}
+
+func createQueryIndex(pairs []string) map[string]string {
+	index := make(map[string]string)
+	for _, pair := range pairs {
+		key, _, _ := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(key)
+		if err != nil || len(key) == 0 {
+			continue
+		}
+		lower := strings.ToLower(key)
+		if _, exists := index[lower]; !exists {
+			index[lower] = key
+		}
+	}
+	return index
+}
+
+func (p *Parser) bindQueryMap(queryKey string, fieldValue any) error {
+	mv := reflect.ValueOf(fieldValue).Elem()
+	keyType := mv.Type().Key()
+	elemType := mv.Type().Elem()
+	if len(queryKey) == 0 {
+		return nil
+	}
+	prefix := p.normalizeQueryKey(queryKey) + "["
+	for key, values := range p.query() {
+		if len(values) == 0 || !strings.HasPrefix(p.normalizeQueryKey(key), prefix) || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		mapKey := key[len(prefix) : len(key)-1]
+		elem := reflect.New(elemType)
+		if err := p.bindValues(values, elem.Interface()); err != nil {
+			return err
+		}
+		if mv.IsNil() {
+			mv.Set(reflect.MakeMap(mv.Type()))
+		}
+		mapKeyValue := reflect.New(keyType)
+		if err := p.convertValue(mapKey, mapKeyValue.Interface()); err != nil {
+			return err
+		}
+		mv.SetMapIndex(mapKeyValue.Elem(), elem.Elem())
+	}
+	return nil
+}
+
+func (p *Parser) normalizeQueryKey(key string) string {
+	if p.caseInsensitiveQuery {
+		return strings.ToLower(key)
+	}
+	return key
+}
+
+func (p *Parser) checkUnknownQuery(consumed map[string]bool) error {
+	unknown := make([]string, 0)
+	for key := range p.query() {
+		base, _, _ := strings.Cut(key, "[")
+		if !consumed[p.normalizeQueryKey(key)] && !consumed[p.normalizeQueryKey(base)] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf("%w: %s", ErrorUnknownQueryParam, strings.Join(unknown, ", "))
+}
@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMaxQueryParamsRepeatedKey(t *testing.T) {
+	rawQuery := strings.Repeat("a=1&", 5000)
+	p := New(httptest.NewRequest("GET", "/?"+rawQuery, nil), nil, 1, WithMaxQueryParams(10))
+	var values []int
+	if err := p.Query("a", &values); !errors.Is(err, ErrorTooManyQueryParams) {
+		t.Fatalf("expected ErrorTooManyQueryParams, got %v", err)
+	}
+	if _, err := p.QueryPairs("a", "", ""); !errors.Is(err, ErrorTooManyQueryParams) {
+		t.Fatalf("expected ErrorTooManyQueryParams from QueryPairs, got %v", err)
+	}
+	if _, err := p.QueryTime("a", ""); !errors.Is(err, ErrorTooManyQueryParams) {
+		t.Fatalf("expected ErrorTooManyQueryParams from QueryTime, got %v", err)
+	}
+	if _, _, err := p.Page(10, 100); !errors.Is(err, ErrorTooManyQueryParams) {
+		t.Fatalf("expected ErrorTooManyQueryParams from Page, got %v", err)
+	}
+}
+
+func TestMaxQueryParamsFragment(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	p := New(r, nil, 1, WithMaxQueryParams(2), WithFragment("a=1&b=2&c=3"))
+	var value string
+	if err := p.QueryFragment("a", &value); !errors.Is(err, ErrorTooManyQueryParams) {
+		t.Fatalf("expected ErrorTooManyQueryParams, got %v", err)
+	}
+}
+
+func TestMaxQueryParamsWithinLimit(t *testing.T) {
+	p := New(httptest.NewRequest("GET", "/?a=1&a=2&b=3&", nil), nil, 1, WithMaxQueryParams(3))
+	var values []int
+	if err := p.Query("a", &values); err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 {
+		t.Fatalf("expected two values, got %v", values)
+	}
+}
+
+func TestQueryStructValidatesFields(t *testing.T) {
+	type filter struct {
+		Status string `query:"status" enum:"open,closed"`
+		Size   int    `min:"1" max:"50"`
+	}
+	cases := map[string]error{
+		"/?status=bogus&size=10": ErrorInvalidEnum,
+		"/?status=open&size=99":  ErrorOutOfRange,
+	}
+	for path, expected := range cases {
+		var target filter
+		if err := New(httptest.NewRequest("GET", path, nil), nil, 1).QueryStruct(&target); !errors.Is(err, expected) {
+			t.Errorf("%s: expected %v, got %v", path, expected, err)
+		}
+	}
+	var target filter
+	if err := New(httptest.NewRequest("GET", "/?status=open&size=10", nil), nil, 1).QueryStruct(&target); err != nil {
+		t.Fatal(err)
+	}
+	if target.Status != "open" || target.Size != 10 {
+		t.Fatalf("unexpected binding %+v", target)
+	}
+}
+
+func TestRejectDuplicateQueryKeysAcrossCase(t *testing.T) {
+	for _, path := range []string{"/?id=1&ID=2", "/?id=1&id=2"} {
+		p := New(httptest.NewRequest("GET", path, nil), nil, 1, WithCaseInsensitiveQuery(), WithRejectDuplicateQueryKeys())
+		var id int
+		if err := p.Query("id", &id); !errors.Is(err, ErrorDuplicateQueryKey) {
+			t.Errorf("%s: expected ErrorDuplicateQueryKey, got %v", path, err)
+		}
+	}
+	p := New(httptest.NewRequest("GET", "/?ID=2", nil), nil, 1, WithCaseInsensitiveQuery(), WithRejectDuplicateQueryKeys())
+	var id int
+	if err := p.Query("id", &id); err != nil || id != 2 {
+		t.Fatalf("unexpected result %d, %v", id, err)
+	}
+}
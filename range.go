@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	headerRange = "Range"
+	rangeUnit   = "bytes="
+)
+
+// HttpRange is an inclusive byte range. End is -1 for an open range like 500-,
+// and Start is -1 for a suffix range like -500, where End holds the suffix length.
+type HttpRange struct {
+	Start int64
+	End   int64
+}
+
+func (p *Parser) RangeHeader() (ranges []HttpRange, err error) {
+	defer p.finish("RangeHeader", p.start(), &err)
+	value := strings.TrimSpace(p.r.Header.Get(headerRange))
+	if len(value) == 0 {
+		return nil, nil
+	}
+	if !strings.HasPrefix(value, rangeUnit) {
+		return nil, ErrorInvalidRange
+	}
+	for _, spec := range strings.Split(value[len(rangeUnit):], ",") {
+		spec = strings.TrimSpace(spec)
+		if len(spec) == 0 {
+			continue
+		}
+		r, err := parseHttpRange(spec)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	if len(ranges) == 0 {
+		return nil, ErrorInvalidRange
+	}
+	return ranges, nil
+}
+
+func (p *Parser) MustRangeHeader() []HttpRange {
+	ranges, err := p.RangeHeader()
+	if err != nil {
+		p.fail(err)
+	}
+	return ranges
+}
+
+func parseHttpRange(spec string) (HttpRange, error) {
+	start, end, ok := strings.Cut(spec, "-")
+	if !ok {
+		return HttpRange{}, ErrorInvalidRange
+	}
+	start, end = strings.TrimSpace(start), strings.TrimSpace(end)
+	if len(start) == 0 {
+		length, err := strconv.ParseInt(end, 10, 64)
+		if err != nil || length <= 0 {
+			return HttpRange{}, ErrorInvalidRange
+		}
+		return HttpRange{Start: -1, End: length}, nil
+	}
+	first, err := strconv.ParseInt(start, 10, 64)
+	if err != nil || first < 0 {
+		return HttpRange{}, ErrorInvalidRange
+	}
+	if len(end) == 0 {
+		return HttpRange{Start: first, End: -1}, nil
+	}
+	last, err := strconv.ParseInt(end, 10, 64)
+	if err != nil || last < first {
+		return HttpRange{}, ErrorInvalidRange
+	}
+	return HttpRange{Start: first, End: last}, nil
+}
@@ -0,0 +1,31 @@
+package parser
+
+import "regexp"
+
+var defaultRedactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)bearer\s+[a-z0-9\-._~+/]+=*`),
+	regexp.MustCompile(`[A-Za-z0-9+/]{40,}={0,2}`),
+}
+
+func (p *Parser) TextRedacted(patterns ...*regexp.Regexp) (text string, err error) {
+	defer p.finish("TextRedacted", p.start(), &err)
+	text, err = p.Text()
+	if err != nil {
+		return "", err
+	}
+	if len(patterns) == 0 {
+		patterns = defaultRedactPatterns
+	}
+	for _, pattern := range patterns {
+		text = pattern.ReplaceAllString(text, redactedValue)
+	}
+	return text, nil
+}
+
+func (p *Parser) MustTextRedacted(patterns ...*regexp.Regexp) string {
+	text, err := p.TextRedacted(patterns...)
+	if err != nil {
+		p.fail(err)
+	}
+	return text
+}
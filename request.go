@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	
+	"github.com/creamsensation/util/constant/header"
+)
+
+var defaultRequestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Traceparent"}
+
+func (p *Parser) Method() string {
+	return p.r.Method
+}
+
+func (p *Parser) Path() string {
+	return p.r.URL.Path
+}
+
+func (p *Parser) RemoteIP() string {
+	remoteIP := p.r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remoteIP); err == nil {
+		remoteIP = host
+	}
+	if !p.isTrustedProxy(remoteIP) {
+		return remoteIP
+	}
+	forwarded := strings.Split(strings.Join(p.r.Header.Values(header.Ip), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwarded[i])
+		if len(ip) == 0 {
+			continue
+		}
+		if !p.isTrustedProxy(ip) {
+			return ip
+		}
+		remoteIP = ip
+	}
+	return remoteIP
+}
+
+func (p *Parser) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range p.trustedProxies {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTrustedProxy(proxy string) (netip.Prefix, bool) {
+	if strings.Contains(proxy, "/") {
+		prefix, err := netip.ParsePrefix(proxy)
+		return prefix.Masked(), err == nil
+	}
+	addr, err := netip.ParseAddr(proxy)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
+
+// RequestID returns the first present request ID header, or a generated UUID when WithGenerateRequestID is set.
+func (p *Parser) RequestID() string {
+	headers := p.requestIDHeaders
+	if len(headers) == 0 {
+		headers = defaultRequestIDHeaders
+	}
+	for _, name := range headers {
+		if id := strings.TrimSpace(p.r.Header.Get(name)); len(id) > 0 {
+			return id
+		}
+	}
+	if p.generateRequestID && len(p.requestID) == 0 {
+		p.requestID = newUUID()
+	}
+	return p.requestID
+}
+
+func newUUID() string {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return ""
+	}
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16])
+}
@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"errors"
+	"os"
+	"sort"
+)
+
+// MultipartSpill reports whether the parsed multipart form exceeded the in-memory threshold
+// and lists the temporary files holding the parts written to disk.
+func (p *Parser) MultipartSpill() (spilled bool, paths []string, err error) {
+	defer p.finish("MultipartSpill", p.start(), &err)
+	if len(p.bytes) > 0 {
+		return false, nil, nil
+	}
+	if err := p.parseMultipartForm(); err != nil {
+		return false, nil, err
+	}
+	if p.r.MultipartForm == nil {
+		return false, nil, nil
+	}
+	names := make([]string, 0, len(p.r.MultipartForm.File))
+	for name := range p.r.MultipartForm.File {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, file := range p.r.MultipartForm.File[name] {
+			f, err := file.Open()
+			if err != nil {
+				return false, nil, errors.Join(ErrorOpenFile, err)
+			}
+			if osFile, ok := f.(*os.File); ok {
+				paths = append(paths, osFile.Name())
+			}
+			f.Close()
+		}
+	}
+	return len(paths) > 0, paths, nil
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (p *Parser) TimeRange(fromKey, toKey string, layout string) (from, to time.Time, err error) {
+	defer p.finish("TimeRange", p.start(), &err)
+	if len(layout) == 0 {
+		layout = time.RFC3339
+	}
+	from, fromExists, err := p.parseQueryTime(fromKey, layout)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, toExists, err := p.parseQueryTime(toKey, layout)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if fromExists && toExists && from.After(to) {
+		return time.Time{}, time.Time{}, ErrorInvalidTimeRange
+	}
+	return from, to, nil
+}
+
+func (p *Parser) QueryTime(key, layout string) (t time.Time, err error) {
+	defer p.finish("QueryTime", p.start(), &err)
+	t, exists, err := p.parseQueryTime(key, layout)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !exists {
+		return time.Time{}, ErrorQueryMissing
+	}
+	return t, nil
+}
+
+func (p *Parser) MustQueryTime(key, layout string) time.Time {
+	t, err := p.QueryTime(key, layout)
+	if err != nil {
+		p.fail(err)
+	}
+	return t
+}
+
+func (p *Parser) QueryDate(key string) (time.Time, error) {
+	return p.QueryTime(key, time.DateOnly)
+}
+
+func (p *Parser) MustQueryDate(key string) time.Time {
+	return p.MustQueryTime(key, time.DateOnly)
+}
+
+func (p *Parser) parseQueryTime(key, layout string) (time.Time, bool, error) {
+	if err := p.checkQueryLimit(); err != nil {
+		return time.Time{}, false, err
+	}
+	values, ok := p.lookupQuery(key)
+	if !ok || len(values) == 0 {
+		return time.Time{}, false, nil
+	}
+	value := values[0]
+	if p.trimSpace {
+		value = strings.TrimSpace(value)
+	}
+	if len(value) == 0 {
+		return time.Time{}, false, nil
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, true, fmt.Errorf("%w %q to time: %w", ErrorConvertValue, value, err)
+	}
+	return t, true, nil
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// Trailer converts a request trailer value into target. Trailers are only populated
+// once the body has been fully read, so call it after the body-reading methods.
+func (p *Parser) Trailer(key string, target any) (err error) {
+	defer p.finish("Trailer", p.start(), &err)
+	value := p.r.Trailer.Get(key)
+	if len(value) == 0 {
+		return ErrorTrailerMissing
+	}
+	return p.convertValue(value, target)
+}
+
+func (p *Parser) MustTrailer(key string, target any) {
+	err := p.Trailer(key, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+// TrailerWait reads the rest of the body and converts the trailer once it arrives,
+// failing with ErrorTrailerTimeout when the body is not finished within timeout.
+// On timeout the call returns at once and the body can no longer be read through the parser, since the
+// server keeps the pending read until the client sends more data or disconnects.
+func (p *Parser) TrailerWait(key string, timeout time.Duration, target any) (err error) {
+	defer p.finish("TrailerWait", p.start(), &err)
+	if len(p.bytes) > 0 || p.r.Body == nil || p.buffer != nil {
+		return p.Trailer(key, target)
+	}
+	body := p.body()
+	var buffer *bytes.Buffer
+	if p.replayBody {
+		buffer = bufferPool.Get().(*bytes.Buffer)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- drainBody(body, buffer)
+	}()
+	select {
+	case err = <-done:
+	case <-time.After(timeout):
+		p.r.Body = io.NopCloser(timedOutBody{})
+		return ErrorTrailerTimeout
+	}
+	if buffer != nil {
+		if err != nil {
+			releaseBuffer(buffer)
+			return err
+		}
+		p.buffer = buffer
+		p.r.Body = io.NopCloser(bytes.NewReader(buffer.Bytes()))
+	}
+	if err != nil {
+		return err
+	}
+	return p.Trailer(key, target)
+}
+
+func (p *Parser) MustTrailerWait(key string, timeout time.Duration, target any) {
+	err := p.TrailerWait(key, timeout, target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+// timedOutBody replaces a body whose drain is still pending after TrailerWait timed out.
+type timedOutBody struct{}
+
+func (timedOutBody) Read([]byte) (int, error) {
+	return 0, ErrorTrailerTimeout
+}
+
+func drainBody(body io.Reader, buffer *bytes.Buffer) error {
+	if buffer != nil {
+		_, err := buffer.ReadFrom(body)
+		return err
+	}
+	_, err := io.Copy(io.Discard, body)
+	return err
+}
@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrailerWaitTimeoutOnServer(t *testing.T) {
+	result := make(chan error, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var checksum string
+		result <- New(r, nil, 1).TrailerWait("Checksum", 50*time.Millisecond, &checksum)
+	}))
+	defer server.Close()
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	request := "POST / HTTP/1.1\r\nHost: example.com\r\nTransfer-Encoding: chunked\r\nTrailer: Checksum\r\n\r\n3\r\nabc\r\n"
+	if _, err := conn.Write([]byte(request)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-result:
+		if !errors.Is(err, ErrorTrailerTimeout) {
+			t.Fatalf("expected ErrorTrailerTimeout, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("TrailerWait did not return after its timeout")
+	}
+}
+
+func TestTrailerWaitTimeout(t *testing.T) {
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	r := httptest.NewRequest("POST", "/", pr)
+	p := New(r, nil, 1)
+	var checksum string
+	if err := p.TrailerWait("Checksum", 10*time.Millisecond, &checksum); !errors.Is(err, ErrorTrailerTimeout) {
+		t.Fatalf("expected ErrorTrailerTimeout, got %v", err)
+	}
+	if _, err := p.Text(); err == nil {
+		t.Fatal("expected reading a timed out body to fail")
+	}
+}
+
+type trailerBody struct {
+	r       *http.Request
+	reader  io.Reader
+	trailer string
+}
+
+func (b *trailerBody) Read(data []byte) (int, error) {
+	n, err := b.reader.Read(data)
+	if err == io.EOF {
+		b.r.Trailer.Set("Checksum", b.trailer)
+	}
+	return n, err
+}
+
+func (b *trailerBody) Close() error {
+	return nil
+}
+
+func TestTrailerWait(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", nil)
+	r.Trailer = http.Header{}
+	r.Body = &trailerBody{r: r, reader: strings.NewReader("payload"), trailer: "abc"}
+	p := New(r, nil, 1, WithRequestBodyReplay())
+	var checksum string
+	if err := p.TrailerWait("Checksum", time.Second, &checksum); err != nil {
+		t.Fatal(err)
+	}
+	if checksum != "abc" {
+		t.Fatalf("expected trailer abc, got %q", checksum)
+	}
+	if text, err := p.Text(); err != nil || text != "payload" {
+		t.Fatalf("expected replayed body, got %q, %v", text, err)
+	}
+}
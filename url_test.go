@@ -0,0 +1,36 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type urlTestDateFilter struct {
+	Before *time.Time `query:"before"`
+}
+
+func TestUrlOptionalTime(t *testing.T) {
+	var present urlTestDateFilter
+	if err := New(httptest.NewRequest("GET", "/?before=2024-01-01", nil), nil, 1).Url(&present); err != nil {
+		t.Fatal(err)
+	}
+	if present.Before == nil || !present.Before.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected date %v", present.Before)
+	}
+	var absent urlTestDateFilter
+	if err := New(httptest.NewRequest("GET", "/", nil), nil, 1).Url(&absent); err != nil {
+		t.Fatal(err)
+	}
+	if absent.Before != nil {
+		t.Fatalf("expected nil date, got %v", absent.Before)
+	}
+	var malformed urlTestDateFilter
+	if err := New(httptest.NewRequest("GET", "/?before=2024-13-45", nil), nil, 1).Url(&malformed); !errors.Is(err, ErrorConvertValue) {
+		t.Fatalf("expected ErrorConvertValue, got %v", err)
+	}
+	if malformed.Before != nil {
+		t.Fatalf("expected nil date after error, got %v", malformed.Before)
+	}
+}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func validateField(fieldInfo reflect.StructField, fieldValue any) error {
+	if err := validateEnum(fieldInfo, fieldValue); err != nil {
+		return err
+	}
+	return validateRange(fieldInfo, fieldValue)
+}
+
+func validateEnum(fieldInfo reflect.StructField, fieldValue any) error {
+	allowed, ok := fieldInfo.Tag.Lookup("enum")
+	if !ok {
+		return nil
+	}
+	values := strings.Split(allowed, ",")
+	for _, item := range fieldItems(fieldValue) {
+		if !slices.Contains(values, fmt.Sprint(item.Interface())) {
+			return fmt.Errorf("%w: %s must be one of %s", ErrorInvalidEnum, fieldInfo.Name, allowed)
+		}
+	}
+	return nil
+}
+
+func validateRange(fieldInfo reflect.StructField, fieldValue any) error {
+	minTag, hasMin := fieldInfo.Tag.Lookup("min")
+	maxTag, hasMax := fieldInfo.Tag.Lookup("max")
+	if !hasMin && !hasMax {
+		return nil
+	}
+	for _, item := range fieldItems(fieldValue) {
+		if hasMin {
+			below, err := compareBound(item, minTag)
+			if err != nil {
+				return fmt.Errorf("%s min %q: %w", fieldInfo.Name, minTag, err)
+			}
+			if below < 0 {
+				return fmt.Errorf("%w: %s must be at least %s", ErrorOutOfRange, fieldInfo.Name, minTag)
+			}
+		}
+		if hasMax {
+			above, err := compareBound(item, maxTag)
+			if err != nil {
+				return fmt.Errorf("%s max %q: %w", fieldInfo.Name, maxTag, err)
+			}
+			if above > 0 {
+				return fmt.Errorf("%w: %s must be at most %s", ErrorOutOfRange, fieldInfo.Name, maxTag)
+			}
+		}
+	}
+	return nil
+}
+
+// compareBound returns -1, 0 or 1 as the numeric value is below, at or above bound. Other kinds compare as 0.
+func compareBound(v reflect.Value, bound string) (int, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b, err := strconv.ParseInt(bound, 10, 64)
+		return cmp.Compare(v.Int(), b), err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b, err := strconv.ParseUint(bound, 10, 64)
+		return cmp.Compare(v.Uint(), b), err
+	case reflect.Float32, reflect.Float64:
+		b, err := strconv.ParseFloat(bound, 64)
+		return cmp.Compare(v.Float(), b), err
+	}
+	return 0, nil
+}
+
+func fieldItems(fieldValue any) []reflect.Value {
+	v := reflect.Indirect(reflect.ValueOf(fieldValue))
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return []reflect.Value{v}
+	}
+	items := make([]reflect.Value, v.Len())
+	for i := range items {
+		items[i] = v.Index(i)
+	}
+	return items
+}
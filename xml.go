@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var xmlUnmarshalerType = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+
+func (p *Parser) XmlStrict(target any) (err error) {
+	defer p.finish("XmlStrict", p.start(), &err)
+	if err := p.checkXmlMediaType(); err != nil {
+		return err
+	}
+	if err := checkPointerTarget(target); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(target)
+	data, err := p.readBytes()
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return p.emptyBody()
+	}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+	if err := decoder.Decode(target); err != nil {
+		return err
+	}
+	return checkUnknownXmlElements(data, t.Elem())
+}
+
+func (p *Parser) MustXmlStrict(target any) {
+	err := p.XmlStrict(target)
+	if err != nil {
+		p.fail(err)
+	}
+}
+
+func checkUnknownXmlElements(data []byte, root reflect.Type) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	stack := make([]xmlNode, 0)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			if len(stack) == 0 {
+				stack = append(stack, xmlNode{typ: root})
+				continue
+			}
+			parent := stack[len(stack)-1]
+			if parent.typ == nil {
+				if err := decoder.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			child, ok := xmlChildType(parent, element.Name.Local)
+			if !ok {
+				return fmt.Errorf("%w: %s", ErrorUnknownXmlElement, element.Name.Local)
+			}
+			stack = append(stack, child)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// xmlNode is an element being checked: a struct type, or one of its a>b
+// intermediate elements when path is set. A nil type accepts any content.
+type xmlNode struct {
+	typ  reflect.Type
+	path []string
+}
+
+func xmlChildType(parent xmlNode, name string) (xmlNode, bool) {
+	if parent.typ.Kind() != reflect.Struct {
+		return xmlNode{}, true
+	}
+	if len(parent.path) == 0 && reflect.PointerTo(parent.typ).Implements(xmlUnmarshalerType) {
+		return xmlNode{}, true
+	}
+	for i := 0; i < parent.typ.NumField(); i++ {
+		field := parent.typ.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		tagName, tagOptions, _ := strings.Cut(field.Tag.Get("xml"), ",")
+		if tagName == "-" {
+			continue
+		}
+		if field.Anonymous && len(tagName) == 0 {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if child, ok := xmlChildType(xmlNode{typ: embedded, path: parent.path}, name); ok {
+					return child, true
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if strings.Contains(","+tagOptions+",", ",any,") || strings.Contains(","+tagOptions+",", ",innerxml,") {
+			if len(parent.path) == 0 {
+				return xmlNode{}, true
+			}
+			continue
+		}
+		if strings.Contains(","+tagOptions+",", ",attr,") || strings.Contains(","+tagOptions+",", ",chardata,") {
+			continue
+		}
+		if len(tagName) == 0 {
+			tagName = field.Name
+		}
+		path := strings.Split(tagName, ">")
+		if len(path) <= len(parent.path) || !slices.Equal(path[:len(parent.path)], parent.path) {
+			continue
+		}
+		if path[len(parent.path)] != name {
+			continue
+		}
+		if len(path) == len(parent.path)+1 {
+			return xmlNode{typ: xmlElemType(field.Type)}, true
+		}
+		return xmlNode{typ: parent.typ, path: path[:len(parent.path)+1]}, true
+	}
+	return xmlNode{}, false
+}
+
+func xmlElemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) {
+		t = t.Elem()
+	}
+	return t
+}
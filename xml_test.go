@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type xmlTestBase struct {
+	ID string `xml:"id"`
+}
+
+type xmlTestDoc struct {
+	xmlTestBase
+	Name  string `xml:"name"`
+	City  string `xml:"address>city"`
+	Phone string `xml:"contact>phone>mobile"`
+}
+
+func newXmlParser(body string) *Parser {
+	r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/xml")
+	return New(r, nil, 1)
+}
+
+func TestXmlStrictEmbeddedAndPathFields(t *testing.T) {
+	var doc xmlTestDoc
+	body := "<doc><id>1</id><name>a</name><address><city>b</city></address><contact><phone><mobile>c</mobile></phone></contact></doc>"
+	if err := newXmlParser(body).XmlStrict(&doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.ID != "1" || doc.Name != "a" || doc.City != "b" || doc.Phone != "c" {
+		t.Fatalf("unexpected document %+v", doc)
+	}
+}
+
+func TestXmlStrictUnknownElements(t *testing.T) {
+	bodies := []string{
+		"<doc><extra>1</extra></doc>",
+		"<doc><address><street>b</street></address></doc>",
+		"<doc><contact><phone><home>c</home></phone></contact></doc>",
+	}
+	for _, body := range bodies {
+		var doc xmlTestDoc
+		if err := newXmlParser(body).XmlStrict(&doc); !errors.Is(err, ErrorUnknownXmlElement) {
+			t.Errorf("%s: expected unknown element error, got %v", body, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"path"
+	"strings"
+	
+	"github.com/creamsensation/form"
+)
+
+const defaultZipEntryName = "file"
+
+// FilesAsZip streams the uploaded files into a zip archive, with entries named by their sanitized filenames.
+// The files pass the same checks as Files before the archive starts. Close the reader when not reading it to the end.
+func (p *Parser) FilesAsZip() (reader io.ReadCloser, err error) {
+	defer p.finish("FilesAsZip", p.start(), &err)
+	if len(p.bytes) > 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	if err := p.parseMultipartForm(); err != nil {
+		return nil, err
+	}
+	files, err := p.createMultiparts()
+	if err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeZip(pw, files))
+	}()
+	return pr, nil
+}
+
+func (p *Parser) MustFilesAsZip() io.ReadCloser {
+	reader, err := p.FilesAsZip()
+	if err != nil {
+		p.fail(err)
+	}
+	return reader
+}
+
+func writeZip(w io.Writer, files []form.Multipart) error {
+	zw := zip.NewWriter(w)
+	for _, m := range files {
+		if err := writeZipEntry(zw, m.Name, bytes.NewReader(m.Data)); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeZipEntry(zw *zip.Writer, filename string, r io.Reader) error {
+	entry, err := zw.Create(sanitizeFilename(filename))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, r)
+	return err
+}
+
+func sanitizeFilename(filename string) string {
+	name := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	name = strings.TrimLeft(name, ".")
+	if len(name) == 0 || name == "/" {
+		return defaultZipEntryName
+	}
+	return name
+}
@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func newZipParser(t *testing.T, filename string, content []byte, options ...Option) *Parser {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(content)
+	writer.Close()
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return New(r, nil, 1, options...)
+}
+
+func TestFilesAsZip(t *testing.T) {
+	reader, err := newZipParser(t, "../notes.txt", []byte("hello")).FilesAsZip()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(archive.File) != 1 || archive.File[0].Name != "notes.txt" {
+		t.Fatalf("unexpected archive entries %+v", archive.File)
+	}
+}
+
+func TestFilesAsZipChecksFileType(t *testing.T) {
+	p := newZipParser(t, "image.png", []byte("%PDF-1.4 document"), WithValidateFileType())
+	if _, err := p.FilesAsZip(); !errors.Is(err, ErrorFileTypeMismatch) {
+		t.Fatalf("expected ErrorFileTypeMismatch, got %v", err)
+	}
+}
+
+func TestFilesAsZipCloseStopsWriter(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 512<<10)
+	before := runtime.NumGoroutine()
+	for i := 0; i < 5; i++ {
+		reader, err := newZipParser(t, "large.txt", content).FilesAsZip()
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader.Read(make([]byte, 16))
+		reader.Close()
+	}
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if leaked := runtime.NumGoroutine() - before; leaked > 0 {
+		t.Fatalf("%d zip writers still running after Close", leaked)
+	}
+}
+
+func TestFilesAsZipChecksTotalUploadSize(t *testing.T) {
+	p := newZipParser(t, "large.txt", bytes.Repeat([]byte("a"), 2000), WithMaxTotalUploadSize(1000))
+	if _, err := p.FilesAsZip(); !errors.Is(err, ErrorUploadTooLarge) {
+		t.Fatalf("expected ErrorUploadTooLarge, got %v", err)
+	}
+}